@@ -0,0 +1,206 @@
+/*
+Use this data source to query the scaling activities of scaling groups.
+
+Example Usage
+
+```hcl
+data "tencentcloud_as_scaling_activities" "activities" {
+  scaling_group_id   = "asg-519acdug"
+  status_code        = "FAILED"
+  activity_type      = "SCALE_OUT"
+  start_time         = "2019-10-01T00:00:00Z"
+  end_time           = "2019-10-31T00:00:00Z"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudAsScalingActivities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAsScalingActivitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Scaling group ID to be queried.",
+			},
+			"status_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(SCALING_GROUP_ACTIVITY_STATUS),
+				Description:  "Status of the scaling activities to be queried, and available values include INIT, RUNNING, SUCCESSFUL, PARTIALLY_SUCCESSFUL, FAILED and CANCELLED.",
+			},
+			"activity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(SCALING_GROUP_ACTIVITY_TYPE),
+				Description:  "Type of the scaling activities to be queried, and available values include SCALE_OUT, SCALE_IN, ATTACH_INSTANCES, REMOVE_INSTANCES, DETACH_INSTANCES, TERMINATE_INSTANCES_UNEXPECTEDLY, REPLACE_UNHEALTHY_INSTANCE and UPDATE_LOAD_BALANCERS.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAsScheduleTimestamp,
+				Description:  "The earliest start time of the scaling activities to be queried, in UTC and the format of YYYY-MM-DDThh:mm:ssZ.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAsScheduleTimestamp,
+				Description:  "The latest end time of the scaling activities to be queried, in UTC and the format of YYYY-MM-DDThh:mm:ssZ.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"activity_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of scaling activities. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Scaling activity ID.",
+						},
+						"scaling_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Scaling group ID.",
+						},
+						"activity_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the scaling activity.",
+						},
+						"status_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the scaling activity.",
+						},
+						"status_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the status.",
+						},
+						"cause": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cause of the scaling activity.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the scaling activity.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start time of the scaling activity.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End time of the scaling activity.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the scaling activity.",
+						},
+						"related_instances": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Instances related to the scaling activity.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Instance ID.",
+									},
+									"instance_status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Status of the instance in the scaling activity.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAsScalingActivitiesRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_as_scaling_activities.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	activities, err := asService.DescribeAutoScalingActivitiesByFilter(ctx,
+		d.Get("scaling_group_id").(string),
+		d.Get("status_code").(string),
+		d.Get("activity_type").(string),
+		d.Get("start_time").(string),
+		d.Get("end_time").(string))
+	if err != nil {
+		return err
+	}
+
+	activityList := make([]map[string]interface{}, 0, len(activities))
+	ids := make([]string, 0, len(activities))
+	for _, activity := range activities {
+		relatedInstances := make([]map[string]interface{}, 0, len(activity.ActivityRelatedInstanceSet))
+		for _, instance := range activity.ActivityRelatedInstanceSet {
+			relatedInstances = append(relatedInstances, map[string]interface{}{
+				"instance_id":     pointerToString(instance.InstanceId),
+				"instance_status": pointerToString(instance.InstanceStatus),
+			})
+		}
+		mapping := map[string]interface{}{
+			"activity_id":       pointerToString(activity.ActivityId),
+			"scaling_group_id":  pointerToString(activity.AutoScalingGroupId),
+			"activity_type":     pointerToString(activity.ActivityType),
+			"status_code":       pointerToString(activity.StatusCode),
+			"status_message":    pointerToString(activity.StatusMessage),
+			"cause":             pointerToString(activity.Cause),
+			"description":       pointerToString(activity.Description),
+			"start_time":        pointerToString(activity.StartTime),
+			"end_time":          pointerToString(activity.EndTime),
+			"create_time":       pointerToString(activity.CreatedTime),
+			"related_instances": relatedInstances,
+		}
+		activityList = append(activityList, mapping)
+		ids = append(ids, pointerToString(activity.ActivityId))
+	}
+
+	d.SetId(dataResourceIdsHash(ids))
+	if err = d.Set("activity_list", activityList); err != nil {
+		log.Printf("[CRITAL]%s provider set scaling activity list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), activityList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -171,7 +171,12 @@ func dataSourceTencentCloudAsScalingGroups() *schema.Resource {
 						"status": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Current status of a scaling group.",
+							Description: "Enabled status of a scaling group, and available values include ENABLED and DISABLED.",
+						},
+						"group_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current status of a scaling group, such as NORMAL, CVM_ABNORMAL, LB_ABNORMAL, VPC_ABNORMAL and INSUFFICIENT_BALANCE.",
 						},
 						"instance_count": {
 							Type:        schema.TypeInt,
@@ -222,7 +227,8 @@ func dataSourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta inter
 			"scaling_group_id":     *scalingGroup.AutoScalingGroupId,
 			"scaling_group_name":   *scalingGroup.AutoScalingGroupName,
 			"configuration_id":     *scalingGroup.LaunchConfigurationId,
-			"status":               *scalingGroup.AutoScalingGroupStatus,
+			"status":               *scalingGroup.EnabledStatus,
+			"group_status":         *scalingGroup.AutoScalingGroupStatus,
 			"instance_count":       *scalingGroup.InstanceCount,
 			"max_size":             *scalingGroup.MaxSize,
 			"min_size":             *scalingGroup.MinSize,
@@ -232,31 +238,11 @@ func dataSourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta inter
 			"default_cooldown":     *scalingGroup.DefaultCooldown,
 			"desired_capacity":     *scalingGroup.DesiredCapacity,
 			"load_balancer_ids":    flattenStringList(scalingGroup.LoadBalancerIdSet),
+			"forward_balancer_ids": flattenAsForwardLoadBalancers(scalingGroup.ForwardLoadBalancerSet),
 			"termination_policies": flattenStringList(scalingGroup.TerminationPolicySet),
 			"retry_policy":         *scalingGroup.RetryPolicy,
 			"create_time":          *scalingGroup.CreatedTime,
 		}
-		if scalingGroup.ForwardLoadBalancerSet != nil && len(scalingGroup.ForwardLoadBalancerSet) > 0 {
-			forwardLoadBalancers := make([]map[string]interface{}, 0, len(scalingGroup.ForwardLoadBalancerSet))
-			for _, v := range scalingGroup.ForwardLoadBalancerSet {
-				targetAttributes := make([]map[string]interface{}, 0, len(v.TargetAttributes))
-				for _, vv := range v.TargetAttributes {
-					targetAttribute := map[string]interface{}{
-						"port":   *vv.Port,
-						"weight": *vv.Weight,
-					}
-					targetAttributes = append(targetAttributes, targetAttribute)
-				}
-				forwardLoadBalancer := map[string]interface{}{
-					"load_balancer_id":  *v.LoadBalancerId,
-					"listener_id":       *v.ListenerId,
-					"target_attributes": targetAttributes,
-					"location_id":       *v.LocationId,
-				}
-				forwardLoadBalancers = append(forwardLoadBalancers, forwardLoadBalancer)
-			}
-			mapping["forward_load_balancers"] = forwardLoadBalancers
-		}
 		scalingGroupList = append(scalingGroupList, mapping)
 	}
 
@@ -23,7 +23,9 @@ func TestAccTencentCloudAsScalingGroupsDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.min_size", "0"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.vpc_id"),
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.subnet_ids.#", "1"),
-					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.status"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.group_status"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.forward_balancer_ids.#", "0"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.create_time"),
 
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.#", "1"),
@@ -33,7 +35,8 @@ func TestAccTencentCloudAsScalingGroupsDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.min_size", "0"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.vpc_id"),
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.subnet_ids.#", "1"),
-					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.status"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.group_status"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups_name", "scaling_group_list.0.create_time"),
 				),
 			},
@@ -64,7 +67,8 @@ func TestAccTencentCloudAsScalingGroupsDataSource_full(t *testing.T) {
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.termination_policies.#", "1"),
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.termination_policies.0", "NEWEST_INSTANCE"),
 					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.retry_policy", "INCREMENTAL_INTERVALS"),
-					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.status"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.group_status"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_groups.scaling_groups", "scaling_group_list.0.create_time"),
 				),
 			},
@@ -0,0 +1,168 @@
+/*
+Use this data source to query the instances of a scaling group.
+
+Example Usage
+
+```hcl
+data "tencentcloud_as_scaling_instances" "instances" {
+  scaling_group_id   = "asg-519acdug"
+  health_status      = "HEALTHY"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudAsScalingInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAsScalingInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Scaling group ID to be queried.",
+			},
+			"health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(SCALING_GROUP_INSTANCE_HEALTH_STATUS),
+				Description:  "Health status of the instances to be queried, and available values include HEALTHY and UNHEALTHY.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"instance_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of instances in the scaling group. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance ID.",
+						},
+						"scaling_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Scaling group ID.",
+						},
+						"configuration_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Launch configuration ID.",
+						},
+						"configuration_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Launch configuration name.",
+						},
+						"life_cycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Life cycle state of the instance, such as IN_SERVICE, CREATING, TERMINATING, ATTACHING, DETACHING, ATTACHING_LB and DETACHING_LB.",
+						},
+						"health_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the instance.",
+						},
+						"protected_from_scale_in": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the instance is protected from scale in.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The available zone of the instance.",
+						},
+						"creation_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation type of the instance, AUTO_CREATION or MANUAL_ATTACHING.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the instance.",
+						},
+						"add_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the instance joined the scaling group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAsScalingInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_as_scaling_instances.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	scalingGroupId := d.Get("scaling_group_id").(string)
+	healthStatus := d.Get("health_status").(string)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	instances, err := asService.DescribeAutoScalingInstances(ctx, scalingGroupId)
+	if err != nil {
+		return err
+	}
+
+	instanceList := make([]map[string]interface{}, 0, len(instances))
+	ids := make([]string, 0, len(instances)+1)
+	ids = append(ids, scalingGroupId)
+	for _, instance := range instances {
+		if healthStatus != "" && pointerToString(instance.HealthStatus) != healthStatus {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"instance_id":        pointerToString(instance.InstanceId),
+			"scaling_group_id":   pointerToString(instance.AutoScalingGroupId),
+			"configuration_id":   pointerToString(instance.LaunchConfigurationId),
+			"configuration_name": pointerToString(instance.LaunchConfigurationName),
+			"life_cycle_state":   pointerToString(instance.LifeCycleState),
+			"health_status":      pointerToString(instance.HealthStatus),
+			"availability_zone":  pointerToString(instance.Zone),
+			"creation_type":      pointerToString(instance.CreationType),
+			"instance_type":      pointerToString(instance.InstanceType),
+			"add_time":           pointerToString(instance.AddTime),
+		}
+		if instance.ProtectedFromScaleIn != nil {
+			mapping["protected_from_scale_in"] = *instance.ProtectedFromScaleIn
+		}
+		instanceList = append(instanceList, mapping)
+		ids = append(ids, pointerToString(instance.InstanceId))
+	}
+
+	d.SetId(dataResourceIdsHash(ids))
+	if err = d.Set("instance_list", instanceList); err != nil {
+		log.Printf("[CRITAL]%s provider set scaling instance list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), instanceList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudAsScalingInstancesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAsScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsScalingInstancesDataSource(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.#", "1"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.instance_id"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.scaling_group_id", "tencentcloud_as_scaling_group.scaling_group", "id"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.configuration_id", "tencentcloud_as_scaling_config.launch_configuration", "id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.life_cycle_state", "IN_SERVICE"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.health_status", "HEALTHY"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.protected_from_scale_in", "false"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.availability_zone", "ap-guangzhou-3"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.creation_type", "AUTO_CREATION"),
+					resource.TestCheckResourceAttr("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.instance_type", "SA1.SMALL1"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_as_scaling_instances.instances", "instance_list.0.add_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAsScalingInstancesDataSource() string {
+	return `
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-configuration"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-scaling-group"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 1
+	min_size = 0
+	desired_capacity = 1
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+	wait_for_capacity = true
+}
+
+data "tencentcloud_as_scaling_instances" "instances" {
+	scaling_group_id = "${tencentcloud_as_scaling_group.scaling_group.id}"
+	health_status = "HEALTHY"
+}
+`
+}
@@ -0,0 +1,168 @@
+/*
+Use this data source to query the CBS configurations available for purchase, such as the size range of each storage type in a zone.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cbs_disk_config_quota" "quota" {
+  availability_zones = ["ap-guangzhou-3"]
+  charge_type        = "POSTPAID_BY_HOUR"
+  storage_types      = ["CLOUD_PREMIUM", "CLOUD_SSD"]
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudCbsDiskConfigQuota() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsDiskConfigQuotaRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zones": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The available zones to be queried.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(CBS_CHARGE_TYPE),
+				Description:  "The charge type of CBS to be queried, and available values include PREPAID and POSTPAID_BY_HOUR.",
+			},
+			"storage_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAllowedStringValue(CBS_STORAGE_TYPE),
+				},
+				Description: "Types of CBS medium to be queried, and available values include CLOUD_BASIC, CLOUD_PREMIUM and CLOUD_SSD.",
+			},
+			"storage_usage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(CBS_STORAGE_USAGE),
+				Description:  "Types of CBS to be queried, and available values include SYSTEM_DISK and DATA_DISK.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"config_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of CBS configurations. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The available zone of the configuration.",
+						},
+						"storage_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of CBS medium.",
+						},
+						"storage_usage": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of CBS, SYSTEM_DISK or DATA_DISK.",
+						},
+						"charge_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The charge type of CBS.",
+						},
+						"min_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The minimum size of CBS in GB.",
+						},
+						"max_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum size of CBS in GB.",
+						},
+						"available": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the configuration is available for purchase.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCbsDiskConfigQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_cbs_disk_config_quota.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	zones := make([]string, 0)
+	for _, v := range d.Get("availability_zones").([]interface{}) {
+		zones = append(zones, v.(string))
+	}
+	storageTypes := make([]string, 0)
+	for _, v := range d.Get("storage_types").([]interface{}) {
+		storageTypes = append(storageTypes, v.(string))
+	}
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	configs, err := cbsService.DescribeDiskConfigQuota(ctx, zones, d.Get("charge_type").(string),
+		storageTypes, d.Get("storage_usage").(string))
+	if err != nil {
+		return err
+	}
+
+	configList := make([]map[string]interface{}, 0, len(configs))
+	ids := make([]string, 0, len(configs))
+	for _, config := range configs {
+		mapping := map[string]interface{}{
+			"availability_zone": pointerToString(config.Zone),
+			"storage_type":      pointerToString(config.DiskType),
+			"storage_usage":     pointerToString(config.DiskUsage),
+			"charge_type":       pointerToString(config.DiskChargeType),
+		}
+		if config.MinDiskSize != nil {
+			mapping["min_size"] = *config.MinDiskSize
+		}
+		if config.MaxDiskSize != nil {
+			mapping["max_size"] = *config.MaxDiskSize
+		}
+		if config.Available != nil {
+			mapping["available"] = *config.Available
+		}
+		configList = append(configList, mapping)
+		ids = append(ids, pointerToString(config.Zone)+FILED_SP+pointerToString(config.DiskType)+FILED_SP+
+			pointerToString(config.DiskUsage)+FILED_SP+pointerToString(config.DiskChargeType))
+	}
+
+	d.SetId(dataResourceIdsHash(ids))
+	if err = d.Set("config_list", configList); err != nil {
+		log.Printf("[CRITAL]%s provider set cbs config list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), configList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudCbsDiskConfigQuotaDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsDiskConfigQuotaDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.#"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.availability_zone", "ap-guangzhou-3"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.storage_type", "CLOUD_PREMIUM"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.storage_usage", "DATA_DISK"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.charge_type", "POSTPAID_BY_HOUR"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.min_size"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.max_size"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_config_quota.quota", "config_list.0.available"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsDiskConfigQuotaDataSource = `
+data "tencentcloud_cbs_disk_config_quota" "quota" {
+  availability_zones = ["ap-guangzhou-3"]
+  charge_type        = "POSTPAID_BY_HOUR"
+  storage_types      = ["CLOUD_PREMIUM"]
+  storage_usage      = "DATA_DISK"
+}
+`
@@ -0,0 +1,178 @@
+/*
+Use this data source to query the operation logs of CBS storages.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cbs_disk_operation_logs" "logs" {
+  storage_ids        = ["disk-kdt0sq6m"]
+  operations         = ["CBS_OPERATION_ATTACH", "CBS_OPERATION_DETACH"]
+  start_time         = "2019-09-01 00:00:00"
+  end_time           = "2019-09-30 00:00:00"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudCbsDiskOperationLogs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsDiskOperationLogsRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    10,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ID list of the CBS to be queried, up to 10 IDs.",
+			},
+			"operations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Operation types to be queried, such as CBS_OPERATION_ATTACH, CBS_OPERATION_DETACH, CBS_OPERATION_RENEW, CBS_OPERATION_EXPAND, CBS_OPERATION_CREATE, CBS_OPERATION_ISOLATE, CBS_OPERATION_MODIFY, ASP_OPERATION_BIND and ASP_OPERATION_UNBIND.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCbsOperationLogTime,
+				Description:  "Only the operations started after this time are returned, such as 2019-09-01 00:00:00.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCbsOperationLogTime,
+				Description:  "Only the operations started before this time are returned, such as 2019-09-30 00:00:00.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"operation_log_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of operation logs. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the CBS.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UIN of the operator.",
+						},
+						"operation": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the operation.",
+						},
+						"operation_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the operation, and available values include SUCCESS, FAILED and PROCESSING.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start time of the operation.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End time of the operation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// the time range and operations are filtered here because DescribeDiskOperationLogs and
+// DescribeSnapshotOperationLogs only support filtering by id, the time format makes string comparison work
+func cbsOperationLogMatched(d *schema.ResourceData, operation, startTime string) bool {
+	if v, ok := d.GetOk("start_time"); ok && startTime < v.(string) {
+		return false
+	}
+	if v, ok := d.GetOk("end_time"); ok && startTime > v.(string) {
+		return false
+	}
+	operations := d.Get("operations").([]interface{})
+	if len(operations) == 0 {
+		return true
+	}
+	for _, v := range operations {
+		if v.(string) == operation {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourceTencentCloudCbsDiskOperationLogsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_cbs_disk_operation_logs.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	storageIds := make([]string, 0)
+	for _, v := range d.Get("storage_ids").([]interface{}) {
+		storageIds = append(storageIds, v.(string))
+	}
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	operationLogs, err := cbsService.DescribeDiskOperationLogs(ctx, storageIds)
+	if err != nil {
+		return err
+	}
+
+	logList := make([]map[string]interface{}, 0, len(operationLogs))
+	ids := make([]string, 0, len(operationLogs)+len(storageIds))
+	ids = append(ids, storageIds...)
+	for _, operationLog := range operationLogs {
+		operation := pointerToString(operationLog.Operation)
+		startTime := pointerToString(operationLog.StartTime)
+		if !cbsOperationLogMatched(d, operation, startTime) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"storage_id":      pointerToString(operationLog.DiskId),
+			"operator":        pointerToString(operationLog.Operator),
+			"operation":       operation,
+			"operation_state": pointerToString(operationLog.OperationState),
+			"start_time":      startTime,
+			"end_time":        pointerToString(operationLog.EndTime),
+		}
+		logList = append(logList, mapping)
+		ids = append(ids, pointerToString(operationLog.DiskId)+FILED_SP+operation+FILED_SP+startTime)
+	}
+
+	d.SetId(dataResourceIdsHash(ids))
+	if err = d.Set("operation_log_list", logList); err != nil {
+		log.Printf("[CRITAL]%s provider set cbs disk operation log list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), logList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudCbsDiskOperationLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCbsStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsDiskOperationLogsDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageExists("tencentcloud_cbs_storage.storage"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_disk_operation_logs.logs", "operation_log_list.#", "1"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_cbs_disk_operation_logs.logs", "operation_log_list.0.storage_id", "tencentcloud_cbs_storage.storage", "id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_disk_operation_logs.logs", "operation_log_list.0.operation", "CBS_OPERATION_CREATE"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_operation_logs.logs", "operation_log_list.0.operator"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_operation_logs.logs", "operation_log_list.0.operation_state"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_disk_operation_logs.logs", "operation_log_list.0.start_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsDiskOperationLogsDataSource = `
+resource "tencentcloud_cbs_storage" "storage" {
+  availability_zone = "ap-guangzhou-3"
+  storage_size      = 50
+  storage_type      = "CLOUD_PREMIUM"
+  storage_name      = "tf-test-storage"
+}
+
+data "tencentcloud_cbs_disk_operation_logs" "logs" {
+  storage_ids = ["${tencentcloud_cbs_storage.storage.id}"]
+  operations  = ["CBS_OPERATION_CREATE"]
+}
+`
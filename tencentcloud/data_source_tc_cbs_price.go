@@ -0,0 +1,217 @@
+/*
+Use this data source to inquire the price of creating, resizing or renewing a CBS storage.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cbs_price" "create" {
+  storage_type = "CLOUD_PREMIUM"
+  storage_size = 50
+  charge_type  = "PREPAID"
+  period       = 1
+}
+
+data "tencentcloud_cbs_price" "resize" {
+  operation    = "resize"
+  storage_id   = "disk-kdt0sq6m"
+  storage_size = 100
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+)
+
+func dataSourceTencentCloudCbsPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"operation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CBS_PRICE_OPERATION_CREATE,
+				ValidateFunc: validateAllowedStringValue(CBS_PRICE_OPERATION),
+				Description:  "The operation to inquire the price for. Available values include create, resize and renew, and default is create.",
+			},
+			"storage_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of an existing CBS storage. It is required when operation is resize or renew.",
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(CBS_STORAGE_TYPE),
+				Description:  "Type of CBS medium, and available values include CLOUD_BASIC, CLOUD_PREMIUM and CLOUD_SSD. It is required when operation is create.",
+			},
+			"storage_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(10, 16000),
+				Description:  "Volume of CBS. It is required when operation is create or resize.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CBS_CHARGE_TYPE_POSTPAID_BY_HOUR,
+				ValidateFunc: validateAllowedStringValue(CBS_CHARGE_TYPE),
+				Description:  "The charge type of CBS, and available values include PREPAID and POSTPAID_BY_HOUR, and default is POSTPAID_BY_HOUR. It only takes effect when operation is create.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 36),
+				Description:  "The purchased or renewed usage period (in month) of CBS, and value range [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36].",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// computed
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price (in CNY) of a prepaid operation.",
+			},
+			"discount_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price (in CNY) of a prepaid operation.",
+			},
+			"unit_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original unit price (in CNY) of a postpaid CBS.",
+			},
+			"unit_price_discount": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted unit price (in CNY) of a postpaid CBS.",
+			},
+			"charge_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Charge unit of the unit price of a postpaid CBS, such as HOUR.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCbsPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_cbs_price.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	operation := d.Get("operation").(string)
+	storageId := d.Get("storage_id").(string)
+	storageType := d.Get("storage_type").(string)
+	storageSize := d.Get("storage_size").(int)
+	chargeType := d.Get("charge_type").(string)
+	period := d.Get("period").(int)
+
+	result := map[string]interface{}{}
+	switch operation {
+	case CBS_PRICE_OPERATION_CREATE:
+		if storageType == "" || storageSize == 0 {
+			return fmt.Errorf("storage_type and storage_size are required when operation is %s", operation)
+		}
+		price, err := cbsService.InquiryPriceCreateDisks(ctx, storageType, storageSize, chargeType, period, d.Get("project_id").(int))
+		if err != nil {
+			return err
+		}
+		result = flattenCbsPrice(price)
+	case CBS_PRICE_OPERATION_RESIZE:
+		if storageId == "" || storageSize == 0 {
+			return fmt.Errorf("storage_id and storage_size are required when operation is %s", operation)
+		}
+		price, err := cbsService.InquiryPriceResizeDisk(ctx, storageId, storageSize)
+		if err != nil {
+			return err
+		}
+		result = flattenCbsPrepayPrice(price)
+	case CBS_PRICE_OPERATION_RENEW:
+		if storageId == "" {
+			return fmt.Errorf("storage_id is required when operation is %s", operation)
+		}
+		price, err := cbsService.InquiryPriceRenewDisks(ctx, storageId, period)
+		if err != nil {
+			return err
+		}
+		result = flattenCbsPrepayPrice(price)
+	}
+
+	for k, v := range result {
+		d.Set(k, v)
+	}
+	d.SetId(dataResourceIdsHash([]string{operation, storageId, storageType, chargeType,
+		fmt.Sprintf("%d-%d-%d", storageSize, period, d.Get("project_id").(int))}))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), result); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenCbsPrice(price *cbs.Price) map[string]interface{} {
+	mapping := map[string]interface{}{}
+	if price == nil {
+		return mapping
+	}
+	if price.OriginalPrice != nil {
+		mapping["original_price"] = *price.OriginalPrice
+	}
+	if price.DiscountPrice != nil {
+		mapping["discount_price"] = *price.DiscountPrice
+	}
+	if price.UnitPrice != nil {
+		mapping["unit_price"] = *price.UnitPrice
+	}
+	if price.UnitPriceDiscount != nil {
+		mapping["unit_price_discount"] = *price.UnitPriceDiscount
+	}
+	if price.ChargeUnit != nil {
+		mapping["charge_unit"] = *price.ChargeUnit
+	}
+	return mapping
+}
+
+func flattenCbsPrepayPrice(price *cbs.PrepayPrice) map[string]interface{} {
+	mapping := map[string]interface{}{}
+	if price == nil {
+		return mapping
+	}
+	if price.OriginalPrice != nil {
+		mapping["original_price"] = *price.OriginalPrice
+	}
+	if price.DiscountPrice != nil {
+		mapping["discount_price"] = *price.DiscountPrice
+	}
+	return mapping
+}
@@ -0,0 +1,58 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudCbsPriceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCbsStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsPriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_cbs_price.prepaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.prepaid", "original_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.prepaid", "discount_price"),
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_cbs_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.postpaid", "unit_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.postpaid", "charge_unit"),
+					testAccCheckStorageExists("tencentcloud_cbs_storage.storage"),
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_cbs_price.resize"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsPriceDataSource = `
+data "tencentcloud_cbs_price" "prepaid" {
+  storage_type = "CLOUD_PREMIUM"
+  storage_size = 50
+  charge_type  = "PREPAID"
+  period       = 2
+}
+
+data "tencentcloud_cbs_price" "postpaid" {
+  storage_type = "CLOUD_PREMIUM"
+  storage_size = 50
+}
+
+resource "tencentcloud_cbs_storage" "storage" {
+  storage_type      = "CLOUD_PREMIUM"
+  storage_name      = "tf-test-storage"
+  storage_size      = 50
+  availability_zone = "ap-guangzhou-3"
+  max_price         = "${data.tencentcloud_cbs_price.postpaid.unit_price_discount + 1}"
+}
+
+data "tencentcloud_cbs_price" "resize" {
+  operation    = "resize"
+  storage_id   = "${tencentcloud_cbs_storage.storage.id}"
+  storage_size = 100
+}
+`
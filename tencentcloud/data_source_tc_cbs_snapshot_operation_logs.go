@@ -0,0 +1,157 @@
+/*
+Use this data source to query the operation logs of CBS snapshots.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cbs_snapshot_operation_logs" "logs" {
+  snapshot_ids       = ["snap-f3io7adt"]
+  operations         = ["SNAP_OPERATION_DELETE", "SNAP_OPERATION_ROLLBACK"]
+  start_time         = "2019-09-01 00:00:00"
+  end_time           = "2019-09-30 00:00:00"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudCbsSnapshotOperationLogs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsSnapshotOperationLogsRead,
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    10,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ID list of the snapshots to be queried, up to 10 IDs.",
+			},
+			"operations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Operation types to be queried, such as SNAP_OPERATION_DELETE, SNAP_OPERATION_ROLLBACK, SNAP_OPERATION_MODIFY, SNAP_OPERATION_CREATE, SNAP_OPERATION_COPY, ASP_OPERATION_CREATE_SNAP and ASP_OPERATION_DELETE_SNAP.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCbsOperationLogTime,
+				Description:  "Only the operations started after this time are returned, such as 2019-09-01 00:00:00.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCbsOperationLogTime,
+				Description:  "Only the operations started before this time are returned, such as 2019-09-30 00:00:00.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"operation_log_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of operation logs. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the snapshot.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UIN of the operator.",
+						},
+						"operation": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the operation.",
+						},
+						"operation_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the operation, and available values include SUCCESS, FAILED and PROCESSING.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start time of the operation.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End time of the operation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCbsSnapshotOperationLogsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_cbs_snapshot_operation_logs.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	snapshotIds := make([]string, 0)
+	for _, v := range d.Get("snapshot_ids").([]interface{}) {
+		snapshotIds = append(snapshotIds, v.(string))
+	}
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	operationLogs, err := cbsService.DescribeSnapshotOperationLogs(ctx, snapshotIds)
+	if err != nil {
+		return err
+	}
+
+	logList := make([]map[string]interface{}, 0, len(operationLogs))
+	ids := make([]string, 0, len(operationLogs)+len(snapshotIds))
+	ids = append(ids, snapshotIds...)
+	for _, operationLog := range operationLogs {
+		operation := pointerToString(operationLog.Operation)
+		startTime := pointerToString(operationLog.StartTime)
+		if !cbsOperationLogMatched(d, operation, startTime) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"snapshot_id":     pointerToString(operationLog.SnapshotId),
+			"operator":        pointerToString(operationLog.Operator),
+			"operation":       operation,
+			"operation_state": pointerToString(operationLog.OperationState),
+			"start_time":      startTime,
+			"end_time":        pointerToString(operationLog.EndTime),
+		}
+		logList = append(logList, mapping)
+		ids = append(ids, pointerToString(operationLog.SnapshotId)+FILED_SP+operation+FILED_SP+startTime)
+	}
+
+	d.SetId(dataResourceIdsHash(ids))
+	if err = d.Set("operation_log_list", logList); err != nil {
+		log.Printf("[CRITAL]%s provider set cbs snapshot operation log list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), logList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
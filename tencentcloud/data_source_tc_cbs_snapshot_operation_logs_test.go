@@ -0,0 +1,47 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudCbsSnapshotOperationLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCbsSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsSnapshotOperationLogsDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotExists("tencentcloud_cbs_snapshot.snapshot"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_snapshot_operation_logs.logs", "operation_log_list.#", "1"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_cbs_snapshot_operation_logs.logs", "operation_log_list.0.snapshot_id", "tencentcloud_cbs_snapshot.snapshot", "id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_snapshot_operation_logs.logs", "operation_log_list.0.operation", "SNAP_OPERATION_CREATE"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_snapshot_operation_logs.logs", "operation_log_list.0.operation_state"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_snapshot_operation_logs.logs", "operation_log_list.0.start_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsSnapshotOperationLogsDataSource = `
+resource "tencentcloud_cbs_storage" "storage" {
+  availability_zone = "ap-guangzhou-3"
+  storage_size      = 50
+  storage_type      = "CLOUD_PREMIUM"
+  storage_name      = "tf-test-storage"
+}
+
+resource "tencentcloud_cbs_snapshot" "snapshot" {
+  storage_id    = "${tencentcloud_cbs_storage.storage.id}"
+  snapshot_name = "tf-test-snapshot"
+}
+
+data "tencentcloud_cbs_snapshot_operation_logs" "logs" {
+  snapshot_ids = ["${tencentcloud_cbs_snapshot.snapshot.id}"]
+  operations   = ["SNAP_OPERATION_CREATE"]
+}
+`
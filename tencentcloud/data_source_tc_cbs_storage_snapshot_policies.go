@@ -0,0 +1,163 @@
+/*
+Use this data source to query the snapshot policies bound to a CBS storage.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cbs_storage_snapshot_policies" "policies" {
+  storage_id         = "disk-kdt0sq6m"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudCbsStorageSnapshotPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsStorageSnapshotPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the CBS to be queried.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"snapshot_policy_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of snapshot policies bound to the CBS. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the snapshot policy.",
+						},
+						"snapshot_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the snapshot policy.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the snapshot policy, and available values include NORMAL and ISOLATED.",
+						},
+						"is_activated": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the snapshot policy is activated.",
+						},
+						"is_permanent": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the snapshots created by this policy are kept permanently.",
+						},
+						"retention_days": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Retention days of the snapshot.",
+						},
+						"repeat_weekdays": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Weekdays of periodic snapshot, 0 means Sunday, 1-6 means Monday to Saturday.",
+						},
+						"repeat_hours": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Trigger times of periodic snapshot.",
+						},
+						"storage_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "ID list of the CBS bound to the snapshot policy.",
+						},
+						"next_trigger_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The next trigger time of the snapshot policy.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the snapshot policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCbsStorageSnapshotPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_cbs_storage_snapshot_policies.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	policies, err := cbsService.DescribeDiskAssociatedAutoSnapshotPolicy(ctx, d.Get("storage_id").(string))
+	if err != nil {
+		return err
+	}
+
+	policyList := make([]map[string]interface{}, 0, len(policies))
+	ids := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		mapping := map[string]interface{}{
+			"snapshot_policy_id":   pointerToString(policy.AutoSnapshotPolicyId),
+			"snapshot_policy_name": pointerToString(policy.AutoSnapshotPolicyName),
+			"status":               pointerToString(policy.AutoSnapshotPolicyState),
+			"next_trigger_time":    pointerToString(policy.NextTriggerTime),
+			"create_time":          pointerToString(policy.CreateTime),
+			"storage_ids":          flattenStringList(policy.DiskIdSet),
+		}
+		if policy.IsActivated != nil {
+			mapping["is_activated"] = *policy.IsActivated
+		}
+		if policy.IsPermanent != nil {
+			mapping["is_permanent"] = *policy.IsPermanent
+		}
+		if policy.RetentionDays != nil {
+			mapping["retention_days"] = *policy.RetentionDays
+		}
+		if len(policy.Policy) > 0 {
+			mapping["repeat_weekdays"] = flattenIntList(policy.Policy[0].DayOfWeek)
+			mapping["repeat_hours"] = flattenIntList(policy.Policy[0].Hour)
+		}
+		policyList = append(policyList, mapping)
+		ids = append(ids, pointerToString(policy.AutoSnapshotPolicyId))
+	}
+
+	d.SetId(dataResourceIdsHash(ids))
+	if err = d.Set("snapshot_policy_list", policyList); err != nil {
+		log.Printf("[CRITAL]%s provider set snapshot policy list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), policyList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
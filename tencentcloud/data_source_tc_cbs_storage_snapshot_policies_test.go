@@ -0,0 +1,51 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudCbsStorageSnapshotPoliciesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCbsSnapshotPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsStorageSnapshotPoliciesDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.#", "1"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.snapshot_policy_id", "tencentcloud_cbs_snapshot_policy.policy", "id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.snapshot_policy_name", "tf-test-snapshot-policy"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.retention_days", "30"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.repeat_weekdays.#", "2"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.repeat_hours.#", "1"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.storage_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_storage_snapshot_policies.policies", "snapshot_policy_list.0.create_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsStorageSnapshotPoliciesDataSource = `
+resource "tencentcloud_cbs_snapshot_policy" "policy" {
+  snapshot_policy_name = "tf-test-snapshot-policy"
+  repeat_weekdays      = [0, 3]
+  repeat_hours         = [0]
+  retention_days       = 30
+}
+
+resource "tencentcloud_cbs_storage" "storage" {
+  availability_zone       = "ap-guangzhou-3"
+  storage_size            = 50
+  storage_type            = "CLOUD_PREMIUM"
+  storage_name            = "tf-test-storage"
+  auto_snapshot_policy_id = "${tencentcloud_cbs_snapshot_policy.policy.id}"
+}
+
+data "tencentcloud_cbs_storage_snapshot_policies" "policies" {
+  storage_id = "${tencentcloud_cbs_storage.storage.id}"
+}
+`
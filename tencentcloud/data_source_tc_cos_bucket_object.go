@@ -37,6 +37,12 @@ func dataSourceTencentCloudCosBucketObject() *schema.Resource {
 				Required:    true,
 				Description: "The full path to the object inside the bucket.",
 			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Version ID of the object to query, the latest version is queried by default.",
+			},
 			"result_output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -93,12 +99,13 @@ func dataSourceTencentCloudCosBucketObjectsRead(d *schema.ResourceData, meta int
 	cosService := CosService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	info, err := cosService.HeadObject(ctx, bucket, key)
+	versionId := d.Get("version_id").(string)
+	info, err := cosService.HeadObjectVersion(ctx, bucket, key, versionId)
 	if err != nil {
 		return err
 	}
 
-	ids := []string{bucket, key}
+	ids := []string{bucket, key, versionId}
 	d.SetId(dataResourceIdsHash(ids))
 	d.Set("cache_control", info.CacheControl)
 	outputMap["cache_control"] = getStringValue(info.CacheControl)
@@ -120,6 +127,9 @@ func dataSourceTencentCloudCosBucketObjectsRead(d *schema.ResourceData, meta int
 		outputMap["storage_class"] = getStringValue(info.StorageClass)
 	}
 
+	d.Set("version_id", info.VersionId)
+	outputMap["version_id"] = getStringValue(info.VersionId)
+
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), outputMap); err != nil {
@@ -138,9 +138,47 @@ func dataSourceTencentCloudCosBuckets() *schema.Resource {
 											},
 										},
 									},
+									"non_current_transition": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Specifies when noncurrent object versions transition.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"non_current_days": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "Specifies the number of days after the object becomes noncurrent when the specific rule action takes effect.",
+												},
+												"storage_class": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Specifies the storage class to which you want the noncurrent object to transition.",
+												},
+											},
+										},
+									},
+									"non_current_expiration": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Specifies when noncurrent object versions expire.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"non_current_days": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "Specifies the number of days after the object becomes noncurrent when the specific rule action takes effect.",
+												},
+											},
+										},
+									},
 								},
 							},
 						},
+						"versioning_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the versioning of the bucket is enabled.",
+						},
 						"website": {
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -157,6 +195,45 @@ func dataSourceTencentCloudCosBuckets() *schema.Resource {
 										Computed:    true,
 										Description: "An absolute path to the document to return in case of a 4XX error.",
 									},
+									"redirect_all_requests_to": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The protocol which all the requests to the website are redirected with.",
+									},
+									"routing_rules": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Routing rules of the website.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition_error_code": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The HTTP error code to match.",
+												},
+												"condition_prefix": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The object key prefix to match.",
+												},
+												"redirect_protocol": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The protocol of the redirected request.",
+												},
+												"redirect_replace_key": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The object key which replaces the whole key of the request.",
+												},
+												"redirect_replace_key_prefix": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The object key prefix which replaces the matched prefix of the request.",
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -203,6 +280,11 @@ func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface
 			return err
 		}
 		bucket["website"] = website
+		versioningEnabled, err := cosService.GetBucketVersioning(ctx, *v.Name)
+		if err != nil {
+			return err
+		}
+		bucket["versioning_enabled"] = versioningEnabled
 
 		bucketList = append(bucketList, bucket)
 	}
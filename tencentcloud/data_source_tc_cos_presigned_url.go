@@ -0,0 +1,126 @@
+/*
+Use this data source to generate a presigned URL to download or upload an object without the credentials.
+
+~> **NOTE:** A new URL is signed every time the data source is read, and anyone holding it can access the object until it expires.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cos_presigned_url" "download" {
+  bucket     = "mycos-1258798060"
+  key        = "releases/app.tar.gz"
+  method     = "GET"
+  expiration = 86400
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	tencentCloudCosPresignedUrlExpirationDefault = 3600
+	tencentCloudCosPresignedUrlExpirationMax     = 604800
+)
+
+func dataSourceTencentCloudCosPresignedUrl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCosPresignedUrlRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bucket that contains the object.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The full path to the object inside the bucket.",
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validateAllowedStringValue([]string{"GET", "PUT"}),
+				Description:  "HTTP method the URL is signed for. Available values include GET and PUT. Default is GET.",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      tencentCloudCosPresignedUrlExpirationDefault,
+				ValidateFunc: validateIntegerInRange(1, tencentCloudCosPresignedUrlExpirationMax),
+				Description:  "Validity period in seconds of the URL, the maximum is 604800 (7 days). Default is 3600.",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version ID of the object to download, it only works with the GET method.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of the URL, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCosPresignedUrlRead(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	method := d.Get("method").(string)
+	versionId := d.Get("version_id").(string)
+	expiration := d.Get("expiration").(int)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	now := time.Now()
+	url, err := cosService.PresignObjectUrl(ctx, bucket, key, method, versionId, time.Duration(expiration)*time.Second)
+	if err != nil {
+		return err
+	}
+	expirationTime := now.Add(time.Duration(expiration) * time.Second).UTC().Format(time.RFC3339)
+
+	ids := []string{bucket, key, method, versionId, strconv.Itoa(expiration)}
+	d.SetId(dataResourceIdsHash(ids))
+	d.Set("url", url)
+	d.Set("expiration_time", expirationTime)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		outputMap := map[string]string{
+			"bucket":          bucket,
+			"key":             key,
+			"method":          method,
+			"url":             url,
+			"expiration_time": expirationTime,
+		}
+		if err = writeToFile(output.(string), outputMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudCosPresignedUrlDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosPresignedUrlDataSource(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.tencentcloud_cos_presigned_url.download", "url",
+						regexp.MustCompile("/tf-object-presigned\\?.*X-Amz-Expires=600&.*X-Amz-Signature=")),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cos_presigned_url.download", "expiration_time"),
+					resource.TestMatchResourceAttr("data.tencentcloud_cos_presigned_url.upload", "url",
+						regexp.MustCompile("/tf-object-upload\\?.*X-Amz-Expires=3600&")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCosPresignedUrlDataSource(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "presigned_bucket" {
+	bucket = "tf-bucket-%d-%s"
+}
+
+resource "tencentcloud_cos_bucket_object" "object_content" {
+	bucket = "${tencentcloud_cos_bucket.presigned_bucket.bucket}"
+	key = "tf-object-presigned"
+	content = "aaaaaaaaaaaaaaaa"
+}
+
+data "tencentcloud_cos_presigned_url" "download" {
+	bucket = "${tencentcloud_cos_bucket_object.object_content.bucket}"
+	key = "${tencentcloud_cos_bucket_object.object_content.key}"
+	expiration = 600
+}
+
+data "tencentcloud_cos_presigned_url" "upload" {
+	bucket = "${tencentcloud_cos_bucket.presigned_bucket.bucket}"
+	key = "tf-object-upload"
+	method = "PUT"
+}
+`, acctest.RandInt(), appid)
+}
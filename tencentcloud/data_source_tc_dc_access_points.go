@@ -0,0 +1,139 @@
+/*
+Use this data source to query access points of the DC.
+
+Example Usage
+
+```hcl
+data "tencentcloud_dc_access_points" "guangzhou" {
+  region_id = "ap-guangzhou"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+)
+
+func dataSourceTencentCloudDcAccessPoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudDcAccessPointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Optional:    true,
+				Description: "Region of the access points to be queried, such as ap-guangzhou.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"access_point_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the access points.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_point_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the access point.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the access point.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the access point, and available values include available and unavailable.",
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location of the access point.",
+						},
+						"line_operators": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Operators supported by the access point.",
+						},
+						"region_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region the access point belongs to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudDcAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "data_source.tencentcloud_dc_access_points.read")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		regionId = ""
+	)
+	if temp, ok := d.GetOk("region_id"); ok {
+		regionId = temp.(string)
+	}
+
+	var infos, err = service.DescribeAccessPoints(ctx, regionId)
+
+	if err != nil {
+		return err
+	}
+	var accessPointList = make([]map[string]interface{}, 0, len(infos))
+
+	for _, item := range infos {
+
+		var infoMap = make(map[string]interface{})
+		infoMap["access_point_id"] = *item.AccessPointId
+		infoMap["name"] = service.strPt2str(item.AccessPointName)
+		infoMap["state"] = service.strPt2str(item.State)
+		infoMap["location"] = service.strPt2str(item.Location)
+		infoMap["line_operators"] = flattenStringList(item.LineOperator)
+		infoMap["region_id"] = service.strPt2str(item.RegionId)
+
+		accessPointList = append(accessPointList, infoMap)
+	}
+
+	if err := d.Set("access_point_list", accessPointList); err != nil {
+		log.Printf("[CRITAL]%s provider set  dc access points fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	m := md5.New()
+	m.Write([]byte(regionId))
+	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), accessPointList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTencentCloudDcAccessPointsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccDataSourceTencentCloudDcAccessPoints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_dc_access_points.guangzhou"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_dc_access_points.guangzhou", "access_point_list.#"),
+					resource.TestCheckResourceAttr("data.tencentcloud_dc_access_points.guangzhou", "region_id", "ap-guangzhou"),
+				),
+			},
+		},
+	})
+}
+
+const TestAccDataSourceTencentCloudDcAccessPoints = `
+data tencentcloud_dc_access_points  guangzhou {
+    region_id = "ap-guangzhou"
+}
+`
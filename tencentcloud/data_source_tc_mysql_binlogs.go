@@ -0,0 +1,127 @@
+/*
+Use this data source to query the binary log files of a MySQL instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mysql_binlogs" "default" {
+  mysql_id           = "cdb-c1nl9rpv"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudMysqlBinlogs() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlBinlogsRead,
+		Schema: map[string]*schema.Schema{
+			"mysql_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID, such as cdb-c1nl9rpv. It is identical to the instance ID displayed in the database console page.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"binlog_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of MySQL binary log files. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the binlog file.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the binlog file in bytes.",
+						},
+						"date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the binlog file was generated.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the log file.",
+						},
+						"intranet_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL for downloads internally.",
+						},
+						"internet_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL for downloads externally.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMysqlBinlogsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_mysql_binlogs.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	mysqlId := d.Get("mysql_id").(string)
+	binlogs, err := mysqlService.DescribeBinlogs(ctx, mysqlId)
+	if err != nil {
+		return fmt.Errorf("api[DescribeBinlogs]fail, return %s", err.Error())
+	}
+
+	binlogList := make([]map[string]interface{}, 0, len(binlogs))
+	ids := make([]string, 0, len(binlogs)+1)
+	ids = append(ids, mysqlId)
+
+	for _, item := range binlogs {
+		mapping := map[string]interface{}{
+			"name":         *item.Name,
+			"size":         *item.Size,
+			"date":         *item.Date,
+			"type":         *item.Type,
+			"intranet_url": *item.IntranetUrl,
+			"internet_url": *item.InternetUrl,
+		}
+		ids = append(ids, *item.Name)
+		binlogList = append(binlogList, mapping)
+	}
+
+	if err := d.Set("binlog_list", binlogList); err != nil {
+		log.Printf("[CRITAL]%s provider set binlog list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(ids))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), binlogList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
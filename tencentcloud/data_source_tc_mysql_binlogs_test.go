@@ -0,0 +1,33 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudMysqlBinlogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlBinlogsDataSourceConfig(MysqlInstanceCommonTestCase),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_binlogs.mysql"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_binlogs.mysql", "binlog_list.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMysqlBinlogsDataSourceConfig(commonTestCase string) string {
+	return fmt.Sprintf(`
+%s
+data "tencentcloud_mysql_binlogs" "mysql" {
+	mysql_id = "${tencentcloud_mysql_instance.default.id}"
+}
+	`, commonTestCase)
+}
@@ -0,0 +1,83 @@
+/*
+Use this data source to query the databases of a MySQL instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mysql_databases" "default" {
+  mysql_id           = "cdb-c1nl9rpv"
+  database_regexp    = "^test"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudMysqlDatabases() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlDatabasesRead,
+		Schema: map[string]*schema.Schema{
+			"mysql_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID, such as cdb-c1nl9rpv. It is identical to the instance ID displayed in the database console page.",
+			},
+			"database_regexp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A regular expression used to filter the database names.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"database_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of database names.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMysqlDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_mysql_databases.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	mysqlId := d.Get("mysql_id").(string)
+	databases, err := mysqlService.DescribeDatabases(ctx, mysqlId, d.Get("database_regexp").(string))
+	if err != nil {
+		return fmt.Errorf("api[DescribeDatabases]fail, return %s", err.Error())
+	}
+
+	if err := d.Set("database_list", databases); err != nil {
+		log.Printf("[CRITAL]%s provider set database list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(append([]string{mysqlId}, databases...)))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), databases); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
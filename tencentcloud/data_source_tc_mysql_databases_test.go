@@ -0,0 +1,36 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudMysqlDatabasesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlDatabasesDataSourceConfig(MysqlInstanceCommonTestCase),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_databases.mysql"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_databases.mysql", "database_list.#"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_databases.mysql", "database_list.#", "1"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_databases.mysql", "database_list.0", "mysql"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMysqlDatabasesDataSourceConfig(commonTestCase string) string {
+	return fmt.Sprintf(`
+%s
+data "tencentcloud_mysql_databases" "mysql" {
+	mysql_id = "${tencentcloud_mysql_instance.default.id}"
+	database_regexp = "^mysql$"
+}
+	`, commonTestCase)
+}
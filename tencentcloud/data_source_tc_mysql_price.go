@@ -0,0 +1,137 @@
+/*
+Use this data source to inquire the price of a MySQL instance before purchasing it.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mysql_price" "default" {
+  availability_zone = "ap-guangzhou-3"
+  mem_size          = 1000
+  volume_size       = 50
+  pay_type          = 0
+  period            = 1
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudMysqlPrice() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlPriceRead,
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Indicates which availability zone will be used.",
+			},
+			"mem_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size (in MB).",
+			},
+			"volume_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size (in GB).",
+			},
+			"pay_type": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedIntValue([]int{MysqlPayByMonth, MysqlPayByUse}),
+				Default:      MysqlPayByUse,
+				Description:  "Pay type of the instance. 0 - Prepaid; 1 - Postpaid by hour, and default is 1.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue(MYSQL_AVAILABLE_PERIOD),
+				Description:  "Period (in month) of a prepaid instance. It is ignored when pay_type is 1.",
+			},
+			"instance_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      MYSQL_INSTANCE_ROLES[0],
+				ValidateFunc: validateAllowedStringValue(MYSQL_INSTANCE_ROLES),
+				Description:  "Role of the instance. Available values include master, ro and dr, and default is master.",
+			},
+			"slave_sync_mode": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedIntValue([]int{0, 1, 2}),
+				Default:      0,
+				Description:  "Data replication mode. 0 - Async replication; 1 - Semisync replication; 2 - Strongsync replication.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price (in CNY) of the instance. It is the price of the whole period for a prepaid instance and the hourly price for a postpaid one.",
+			},
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price (in CNY) of the instance.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMysqlPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_mysql_price.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	zone := d.Get("availability_zone").(string)
+	memSize := int64(d.Get("mem_size").(int))
+	volumeSize := int64(d.Get("volume_size").(int))
+	period := int64(d.Get("period").(int))
+	instanceRole := d.Get("instance_role").(string)
+	protectMode := int64(d.Get("slave_sync_mode").(int))
+
+	payType := MYSQL_CHARGE_TYPE_POSTPAID
+	if d.Get("pay_type").(int) == MysqlPayByMonth {
+		payType = MYSQL_CHARGE_TYPE_PREPAID
+	}
+
+	price, originalPrice, err := mysqlService.DescribeDBPrice(ctx, zone, memSize, volumeSize, payType, period, instanceRole, protectMode)
+	if err != nil {
+		return fmt.Errorf("api[DescribeDBPrice]fail, return %s", err.Error())
+	}
+
+	result := map[string]interface{}{
+		"price":          float64(price) / 100,
+		"original_price": float64(originalPrice) / 100,
+	}
+	d.Set("price", result["price"])
+	d.Set("original_price", result["original_price"])
+
+	d.SetId(dataResourceIdsHash([]string{zone, instanceRole, payType,
+		fmt.Sprintf("%d-%d-%d-%d", memSize, volumeSize, period, protectMode)}))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), result); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudMysqlPriceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlPriceDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_price.prepaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.prepaid", "price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.prepaid", "original_price"),
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.postpaid", "price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMysqlPriceDataSourceConfig = `
+data "tencentcloud_mysql_price" "prepaid" {
+  availability_zone = "ap-guangzhou-3"
+  mem_size          = 1000
+  volume_size       = 50
+  pay_type          = 0
+  period            = 2
+}
+
+data "tencentcloud_mysql_price" "postpaid" {
+  availability_zone = "ap-guangzhou-3"
+  mem_size          = 1000
+  volume_size       = 50
+  slave_sync_mode   = 1
+}
+`
@@ -0,0 +1,33 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudMysqlSlowLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlSlowLogsDataSourceConfig(MysqlInstanceCommonTestCase),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_slow_logs.mysql"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_slow_logs.mysql", "slow_log_list.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMysqlSlowLogsDataSourceConfig(commonTestCase string) string {
+	return fmt.Sprintf(`
+%s
+data "tencentcloud_mysql_slow_logs" "mysql" {
+	mysql_id = "${tencentcloud_mysql_instance.default.id}"
+}
+	`, commonTestCase)
+}
@@ -0,0 +1,103 @@
+/*
+Use this data source to query the master/slave switch records of a MySQL instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mysql_switch_records" "default" {
+  mysql_id           = "cdb-c1nl9rpv"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudMysqlSwitchRecords() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlSwitchRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"mysql_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID, such as cdb-c1nl9rpv. It is identical to the instance ID displayed in the database console page.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"switch_record_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of switch records. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"switch_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the switch happened.",
+						},
+						"switch_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the switch. Available values include TRANSFER (data migration), MASTER2SLAVE (master/slave switch) and RECOVERY (master/slave recovery).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMysqlSwitchRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_mysql_switch_records.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	mysqlId := d.Get("mysql_id").(string)
+	records, err := mysqlService.DescribeDBSwitchRecords(ctx, mysqlId)
+	if err != nil {
+		return fmt.Errorf("api[DescribeDBSwitchRecords]fail, return %s", err.Error())
+	}
+
+	recordList := make([]map[string]interface{}, 0, len(records))
+	ids := make([]string, 0, len(records)+1)
+	ids = append(ids, mysqlId)
+
+	for _, item := range records {
+		mapping := map[string]interface{}{
+			"switch_time": *item.SwitchTime,
+			"switch_type": *item.SwitchType,
+		}
+		ids = append(ids, *item.SwitchTime)
+		recordList = append(recordList, mapping)
+	}
+
+	if err := d.Set("switch_record_list", recordList); err != nil {
+		log.Printf("[CRITAL]%s provider set switch record list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(ids))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), recordList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
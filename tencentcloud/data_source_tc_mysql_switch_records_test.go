@@ -0,0 +1,33 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudMysqlSwitchRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlSwitchRecordsDataSourceConfig(MysqlInstanceCommonTestCase),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_switch_records.mysql"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_switch_records.mysql", "switch_record_list.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMysqlSwitchRecordsDataSourceConfig(commonTestCase string) string {
+	return fmt.Sprintf(`
+%s
+data "tencentcloud_mysql_switch_records" "mysql" {
+	mysql_id = "${tencentcloud_mysql_instance.default.id}"
+}
+	`, commonTestCase)
+}
@@ -0,0 +1,90 @@
+/*
+Use this data source to query the tables of a database in a MySQL instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mysql_tables" "default" {
+  mysql_id           = "cdb-c1nl9rpv"
+  database           = "mysql"
+  table_regexp       = "^user"
+  result_output_file = "mytestpath"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentCloudMysqlTables() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlTablesRead,
+		Schema: map[string]*schema.Schema{
+			"mysql_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID, such as cdb-c1nl9rpv. It is identical to the instance ID displayed in the database console page.",
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the database whose tables to query.",
+			},
+			"table_regexp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A regular expression used to filter the table names.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"table_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of table names.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMysqlTablesRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_mysql_tables.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	mysqlId := d.Get("mysql_id").(string)
+	database := d.Get("database").(string)
+	tables, err := mysqlService.DescribeTables(ctx, mysqlId, database, d.Get("table_regexp").(string))
+	if err != nil {
+		return fmt.Errorf("api[DescribeTables]fail, return %s", err.Error())
+	}
+
+	if err := d.Set("table_list", tables); err != nil {
+		log.Printf("[CRITAL]%s provider set table list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(append([]string{mysqlId, database}, tables...)))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), tables); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudMysqlTablesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlTablesDataSourceConfig(MysqlInstanceCommonTestCase),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_tables.mysql"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_tables.mysql", "table_list.#"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_tables.mysql", "table_list.#", "1"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_tables.mysql", "table_list.0", "user"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMysqlTablesDataSourceConfig(commonTestCase string) string {
+	return fmt.Sprintf(`
+%s
+data "tencentcloud_mysql_tables" "mysql" {
+	mysql_id = "${tencentcloud_mysql_instance.default.id}"
+	database = "mysql"
+	table_regexp = "^user$"
+}
+	`, commonTestCase)
+}
@@ -0,0 +1,166 @@
+/*
+Use this data source to query the backups of a redis instance and their download urls.
+
+Example Usage
+
+```hcl
+data "tencentcloud_redis_backups" "redislab" {
+  redis_id           = "crs-7yl0q0dd"
+  begin_time         = "2019-09-01 00:00:00"
+  end_time           = "2019-09-30 00:00:00"
+  result_output_file = "/tmp/redis_backups"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentRedisBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentRedisBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the redis instance to be queried.",
+			},
+			"begin_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the backups started after this time are returned, such as 2019-09-01 00:00:00.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the backups started before this time are returned, such as 2019-09-30 00:00:00.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"backup_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of backups. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the backup.",
+						},
+						"backup_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the backup, maybe: manualBackupInstance and systemBackupInstance.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the backup, maybe: locked, normal, exporting, exported and expired.",
+						},
+						"remark": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Remark of the backup.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the backup is locked.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the backup was started.",
+						},
+						"download_urls": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Public network download urls of the backup, which are valid for 6 hours. It is empty for an expired backup.",
+						},
+						"inner_download_urls": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Private network download urls of the backup, which are valid for 6 hours. It is empty for an expired backup.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentRedisBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_redis_backups.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	redisId := d.Get("redis_id").(string)
+
+	backups, err := service.DescribeInstanceBackups(ctx, redisId, d.Get("begin_time").(string), d.Get("end_time").(string))
+	if err != nil {
+		return fmt.Errorf("api[DescribeInstanceBackups]fail, return %s", err.Error())
+	}
+
+	backupList := make([]map[string]interface{}, 0, len(backups))
+	ids := make([]string, 0, len(backups)+1)
+	ids = append(ids, redisId)
+
+	for _, backup := range backups {
+		status := REDIS_BACKUP_STATUS[*backup.Status]
+		if status == "" {
+			status = "unknown"
+		}
+		mapping := map[string]interface{}{
+			"backup_id":           *backup.BackupId,
+			"backup_type":         *backup.BackupType,
+			"status":              status,
+			"remark":              pointerToString(backup.Remark),
+			"locked":              *backup.Locked == 1,
+			"start_time":          *backup.StartTime,
+			"download_urls":       []string{},
+			"inner_download_urls": []string{},
+		}
+		if *backup.Status != REDIS_BACKUP_STATUS_EXPIRED {
+			downloadUrls, innerDownloadUrls, err := service.DescribeBackupUrl(ctx, redisId, *backup.BackupId)
+			if err != nil {
+				return fmt.Errorf("api[DescribeBackupUrl]fail, return %s", err.Error())
+			}
+			mapping["download_urls"] = downloadUrls
+			mapping["inner_download_urls"] = innerDownloadUrls
+		}
+		ids = append(ids, *backup.BackupId)
+		backupList = append(backupList, mapping)
+	}
+
+	if err := d.Set("backup_list", backupList); err != nil {
+		log.Printf("[CRITAL]%s provider set redis backup list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(ids))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), backupList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
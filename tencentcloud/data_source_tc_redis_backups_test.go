@@ -0,0 +1,50 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudRedisBackupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTencentCloudRedisBackupsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_redis_backups.redis"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_backups.redis", "backup_list.#", "1"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_backups.redis", "backup_list.0.backup_id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_backups.redis", "backup_list.0.backup_type", "manualBackupInstance"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_backups.redis", "backup_list.0.remark", "terraform_test"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_backups.redis", "backup_list.0.start_time"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_backups.redis", "backup_list.0.download_urls.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTencentCloudRedisBackupsDataSourceConfig() string {
+	return `
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone="ap-guangzhou-3"
+	type="master_slave_redis"
+	password="test12345789"
+	mem_size=8192
+	name="terrform_test"
+	port=6379
+}
+
+resource "tencentcloud_redis_backup" "redis_backup" {
+	redis_id = "${tencentcloud_redis_instance.redis_instance_test.id}"
+	remark   = "terraform_test"
+}
+
+data "tencentcloud_redis_backups" "redis" {
+	redis_id = "${tencentcloud_redis_backup.redis_backup.redis_id}"
+}
+	`
+}
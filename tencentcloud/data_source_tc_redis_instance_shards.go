@@ -0,0 +1,157 @@
+/*
+Use this data source to query the shards of a cluster edition redis instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_redis_instance_shards" "redislab" {
+  redis_id           = "crs-7yl0q0dd"
+  filter_slave       = true
+  result_output_file = "/tmp/redis_instance_shards"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentRedisInstanceShards() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentRedisInstanceShardsRead,
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the redis instance to be queried.",
+			},
+			"filter_slave": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to filter out the slave nodes, and default is false.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"shard_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of shard nodes. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"shard_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the shard node.",
+						},
+						"shard_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the shard node.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role of the shard node, maybe: master and slave.",
+						},
+						"keys": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of keys in the shard node.",
+						},
+						"slots": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Slots of the shard node.",
+						},
+						"storage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Used storage of the shard node.",
+						},
+						"storage_slope": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Storage slope of the shard node.",
+						},
+						"run_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Run ID of the shard node.",
+						},
+						"connected": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the shard node is in service.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentRedisInstanceShardsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_redis_instance_shards.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	redisId := d.Get("redis_id").(string)
+
+	shards, err := service.DescribeInstanceShards(ctx, redisId, d.Get("filter_slave").(bool))
+	if err != nil {
+		return fmt.Errorf("api[DescribeInstanceShards]fail, return %s", err.Error())
+	}
+
+	shardList := make([]map[string]interface{}, 0, len(shards))
+	ids := make([]string, 0, len(shards)+1)
+	ids = append(ids, redisId)
+
+	for _, shard := range shards {
+		role := REDIS_SHARD_ROLE[*shard.Role]
+		if role == "" {
+			role = "unknown"
+		}
+		mapping := map[string]interface{}{
+			"shard_id":      *shard.ShardId,
+			"shard_name":    *shard.ShardName,
+			"role":          role,
+			"keys":          *shard.Keys,
+			"slots":         *shard.Slots,
+			"storage":       *shard.Storage,
+			"storage_slope": *shard.StorageSlope,
+			"run_id":        *shard.Runid,
+			"connected":     *shard.Connected == 1,
+		}
+		ids = append(ids, *shard.ShardId+*shard.Runid)
+		shardList = append(shardList, mapping)
+	}
+
+	if err := d.Set("shard_list", shardList); err != nil {
+		log.Printf("[CRITAL]%s provider set redis shard list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(ids))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), shardList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
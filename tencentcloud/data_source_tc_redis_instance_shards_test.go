@@ -0,0 +1,46 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudRedisInstanceShardsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTencentCloudRedisInstanceShardsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_redis_instance_shards.redis"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_instance_shards.redis", "shard_list.#", "3"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_instance_shards.redis", "shard_list.0.shard_id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_instance_shards.redis", "shard_list.0.role", "master"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_instance_shards.redis", "shard_list.0.slots"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTencentCloudRedisInstanceShardsDataSourceConfig() string {
+	return `
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone  = "ap-guangzhou-3"
+	type               = "cluster_redis"
+	password           = "test12345789"
+	mem_size           = 4096
+	redis_shard_num    = 3
+	redis_replicas_num = 1
+	name               = "terrform_test"
+	port               = 6379
+}
+
+data "tencentcloud_redis_instance_shards" "redis" {
+	redis_id     = "${tencentcloud_redis_instance.redis_instance_test.id}"
+	filter_slave = true
+}
+	`
+}
@@ -0,0 +1,136 @@
+/*
+Use this data source to query the parameter modification records of a redis instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_redis_param_records" "redislab" {
+  redis_id           = "crs-7yl0q0dd"
+  param_name         = "maxmemory-policy"
+  result_output_file = "/tmp/redis_param_records"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTencentRedisParamRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentRedisParamRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the redis instance to be queried.",
+			},
+			"param_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the parameter used to filter the records.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"record_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of parameter modification records. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"param_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the parameter.",
+						},
+						"pre_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the parameter before modification.",
+						},
+						"new_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the parameter after modification.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the modification, maybe: modifying, success and failed.",
+						},
+						"modify_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the parameter was modified.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentRedisParamRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_redis_param_records.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	redisId := d.Get("redis_id").(string)
+	paramName := d.Get("param_name").(string)
+
+	records, err := service.DescribeInstanceParamRecords(ctx, redisId)
+	if err != nil {
+		return fmt.Errorf("api[DescribeInstanceParamRecords]fail, return %s", err.Error())
+	}
+
+	recordList := make([]map[string]interface{}, 0, len(records))
+	ids := make([]string, 0, len(records)+1)
+	ids = append(ids, redisId)
+
+	for _, record := range records {
+		if paramName != "" && *record.ParamName != paramName {
+			continue
+		}
+		status := REDIS_PARAM_STATUS[*record.Status]
+		if status == "" {
+			status = "unknown"
+		}
+		mapping := map[string]interface{}{
+			"param_name":  *record.ParamName,
+			"pre_value":   *record.PreValue,
+			"new_value":   *record.NewValue,
+			"status":      status,
+			"modify_time": *record.ModifyTime,
+		}
+		ids = append(ids, *record.ParamName+*record.ModifyTime)
+		recordList = append(recordList, mapping)
+	}
+
+	if err := d.Set("record_list", recordList); err != nil {
+		log.Printf("[CRITAL]%s provider set redis param record list fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash(ids))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), recordList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudRedisParamRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTencentCloudRedisParamRecordsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_redis_param_records.redis"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_param_records.redis", "record_list.#"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_param_records.redis", "record_list.0.param_name", "timeout"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_param_records.redis", "record_list.0.new_value", "300"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_param_records.redis", "record_list.0.status"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_param_records.redis", "record_list.0.modify_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTencentCloudRedisParamRecordsDataSourceConfig() string {
+	return `
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone="ap-guangzhou-3"
+	type="master_slave_redis"
+	password="test12345789"
+	mem_size=8192
+	name="terrform_test"
+	port=6379
+	params = {
+		timeout = "300"
+	}
+}
+
+data "tencentcloud_redis_param_records" "redis" {
+	redis_id   = "${tencentcloud_redis_instance.redis_instance_test.id}"
+	param_name = "timeout"
+}
+	`
+}
@@ -0,0 +1,164 @@
+/*
+Use this data source to inquire the price of creating a VPN gateway.
+
+Example Usage
+
+```hcl
+data "tencentcloud_vpn_gateway_price" "default" {
+  bandwidth   = 10
+  charge_type = "PREPAID"
+  period      = 1
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func dataSourceTencentCloudVpnGatewayPrice() *schema.Resource {
+	itemPrice := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"unit_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original unit price (in CNY) of a postpaid VPN gateway.",
+			},
+			"charge_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Charge unit of the unit price of a postpaid VPN gateway, such as HOUR.",
+			},
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price (in CNY) of a prepaid VPN gateway.",
+			},
+			"discount_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price (in CNY) of a prepaid VPN gateway.",
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudVpnGatewayPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAllowedIntValue(VPN_AVAILABLE_BANDWIDTH),
+				Description:  "The public network bandwidth (in Mbps) of the VPN gateway. Available values include 5, 10, 20, 50 and 100.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      VPN_CHARGE_TYPE_POSTPAID_BY_HOUR,
+				ValidateFunc: validateAllowedStringValue(VPN_CHARGE_TYPES),
+				Description:  "Charge type of the VPN gateway. Available values include PREPAID and POSTPAID_BY_HOUR, and default is POSTPAID_BY_HOUR.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 36),
+				Description:  "The purchased usage period (in month) of a prepaid VPN gateway. It is ignored when charge_type is POSTPAID_BY_HOUR.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// computed
+			"instance_price": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Price of the VPN gateway instance. Each element contains the following attributes:",
+				Elem:        itemPrice,
+			},
+			"bandwidth_price": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Price of the public network bandwidth. Each element contains the following attributes:",
+				Elem:        itemPrice,
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudVpnGatewayPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("data_source.tencentcloud_vpn_gateway_price.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	bandwidth := d.Get("bandwidth").(int)
+	chargeType := d.Get("charge_type").(string)
+	period := d.Get("period").(int)
+
+	price, err := vpcService.InquiryPriceCreateVpnGateway(ctx, bandwidth, chargeType, period)
+	if err != nil {
+		return err
+	}
+
+	result := map[string]interface{}{
+		"instance_price":  []interface{}{},
+		"bandwidth_price": []interface{}{},
+	}
+	if price != nil {
+		if price.InstancePrice != nil {
+			result["instance_price"] = []interface{}{flattenVpcItemPrice(price.InstancePrice)}
+		}
+		if price.BandwidthPrice != nil {
+			result["bandwidth_price"] = []interface{}{flattenVpcItemPrice(price.BandwidthPrice)}
+		}
+	}
+
+	if err := d.Set("instance_price", result["instance_price"]); err != nil {
+		log.Printf("[CRITAL]%s provider set instance price fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	if err := d.Set("bandwidth_price", result["bandwidth_price"]); err != nil {
+		log.Printf("[CRITAL]%s provider set bandwidth price fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+	d.SetId(dataResourceIdsHash([]string{chargeType, fmt.Sprintf("%d-%d", bandwidth, period)}))
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), result); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenVpcItemPrice(price *vpc.ItemPrice) map[string]interface{} {
+	mapping := map[string]interface{}{}
+	if price.UnitPrice != nil {
+		mapping["unit_price"] = *price.UnitPrice
+	}
+	if price.ChargeUnit != nil {
+		mapping["charge_unit"] = *price.ChargeUnit
+	}
+	if price.OriginalPrice != nil {
+		mapping["original_price"] = *price.OriginalPrice
+	}
+	if price.DiscountPrice != nil {
+		mapping["discount_price"] = *price.DiscountPrice
+	}
+	return mapping
+}
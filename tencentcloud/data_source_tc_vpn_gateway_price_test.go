@@ -0,0 +1,38 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudVpnGatewayPriceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnGatewayPriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_vpn_gateway_price.prepaid"),
+					resource.TestCheckResourceAttr("data.tencentcloud_vpn_gateway_price.prepaid", "instance_price.#", "1"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_vpn_gateway_price.prepaid", "instance_price.0.discount_price"),
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_vpn_gateway_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_vpn_gateway_price.postpaid", "instance_price.0.unit_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVpnGatewayPriceDataSource = `
+data "tencentcloud_vpn_gateway_price" "prepaid" {
+  bandwidth   = 10
+  charge_type = "PREPAID"
+  period      = 1
+}
+
+data "tencentcloud_vpn_gateway_price" "postpaid" {
+  bandwidth = 5
+}
+`
@@ -125,3 +125,63 @@ const (
 	SCALING_GROUP_ACTIVITY_STATUS_FAILED               = "FAILED"
 	SCALING_GROUP_ACTIVITY_STATUS_CANCELLED            = "CANCELLED"
 )
+
+var SCALING_GROUP_ACTIVITY_STATUS = []string{
+	SCALING_GROUP_ACTIVITY_STATUS_INIT,
+	SCALING_GROUP_ACTIVITY_STATUS_RUNNING,
+	SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL,
+	SCALING_GROUP_ACTIVITY_STATUS_PARTIALLY_SUCCESSFUL,
+	SCALING_GROUP_ACTIVITY_STATUS_FAILED,
+	SCALING_GROUP_ACTIVITY_STATUS_CANCELLED,
+}
+
+const (
+	SCALING_GROUP_ACTIVITY_TYPE_SCALE_OUT                        = "SCALE_OUT"
+	SCALING_GROUP_ACTIVITY_TYPE_SCALE_IN                         = "SCALE_IN"
+	SCALING_GROUP_ACTIVITY_TYPE_ATTACH_INSTANCES                 = "ATTACH_INSTANCES"
+	SCALING_GROUP_ACTIVITY_TYPE_REMOVE_INSTANCES                 = "REMOVE_INSTANCES"
+	SCALING_GROUP_ACTIVITY_TYPE_DETACH_INSTANCES                 = "DETACH_INSTANCES"
+	SCALING_GROUP_ACTIVITY_TYPE_TERMINATE_INSTANCES_UNEXPECTEDLY = "TERMINATE_INSTANCES_UNEXPECTEDLY"
+	SCALING_GROUP_ACTIVITY_TYPE_REPLACE_UNHEALTHY_INSTANCE       = "REPLACE_UNHEALTHY_INSTANCE"
+	SCALING_GROUP_ACTIVITY_TYPE_UPDATE_LOAD_BALANCERS            = "UPDATE_LOAD_BALANCERS"
+)
+
+var SCALING_GROUP_ACTIVITY_TYPE = []string{
+	SCALING_GROUP_ACTIVITY_TYPE_SCALE_OUT,
+	SCALING_GROUP_ACTIVITY_TYPE_SCALE_IN,
+	SCALING_GROUP_ACTIVITY_TYPE_ATTACH_INSTANCES,
+	SCALING_GROUP_ACTIVITY_TYPE_REMOVE_INSTANCES,
+	SCALING_GROUP_ACTIVITY_TYPE_DETACH_INSTANCES,
+	SCALING_GROUP_ACTIVITY_TYPE_TERMINATE_INSTANCES_UNEXPECTEDLY,
+	SCALING_GROUP_ACTIVITY_TYPE_REPLACE_UNHEALTHY_INSTANCE,
+	SCALING_GROUP_ACTIVITY_TYPE_UPDATE_LOAD_BALANCERS,
+}
+
+const (
+	SCALING_GROUP_INSTANCE_LIFE_CYCLE_STATE_IN_SERVICE = "IN_SERVICE"
+	SCALING_GROUP_INSTANCE_HEALTH_STATUS_HEALTHY       = "HEALTHY"
+	SCALING_GROUP_INSTANCE_HEALTH_STATUS_UNHEALTHY     = "UNHEALTHY"
+)
+
+const (
+	SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO_CREATION    = "AUTO_CREATION"
+	SCALING_GROUP_INSTANCE_CREATION_TYPE_MANUAL_ATTACHING = "MANUAL_ATTACHING"
+)
+
+var SCALING_GROUP_INSTANCE_HEALTH_STATUS = []string{
+	SCALING_GROUP_INSTANCE_HEALTH_STATUS_HEALTHY,
+	SCALING_GROUP_INSTANCE_HEALTH_STATUS_UNHEALTHY,
+}
+
+// the time layout accepted by the StartTime and EndTime of DescribeAutoScalingActivities
+const SCALING_GROUP_ACTIVITY_TIME_LAYOUT = "2006-01-02T15:04:05Z"
+
+const (
+	SCALING_GROUP_ENABLED_STATUS_ENABLED  = "ENABLED"
+	SCALING_GROUP_ENABLED_STATUS_DISABLED = "DISABLED"
+)
+
+var SCALING_GROUP_ENABLED_STATUS = []string{
+	SCALING_GROUP_ENABLED_STATUS_ENABLED,
+	SCALING_GROUP_ENABLED_STATUS_DISABLED,
+}
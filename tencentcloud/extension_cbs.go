@@ -16,6 +16,21 @@ const (
 
 	CBS_SNAPSHOT_STATUS_NORMAL   = "NORMAL"
 	CBS_SNAPSHOT_STATUS_CREATING = "CREATING"
+
+	CBS_CHARGE_TYPE_PREPAID          = "PREPAID"
+	CBS_CHARGE_TYPE_POSTPAID_BY_HOUR = "POSTPAID_BY_HOUR"
+
+	CBS_PRICE_OPERATION_CREATE = "create"
+	CBS_PRICE_OPERATION_RESIZE = "resize"
+	CBS_PRICE_OPERATION_RENEW  = "renew"
+
+	CBS_RENEW_FLAG_NOTIFY_AND_AUTO_RENEW           = "NOTIFY_AND_AUTO_RENEW"
+	CBS_RENEW_FLAG_NOTIFY_AND_MANUAL_RENEW         = "NOTIFY_AND_MANUAL_RENEW"
+	CBS_RENEW_FLAG_DISABLE_NOTIFY_AND_MANUAL_RENEW = "DISABLE_NOTIFY_AND_MANUAL_RENEW"
+
+	CBS_CONFIG_INQUIRY_TYPE_CBS = "INQUIRY_CBS_CONFIG"
+
+	CBS_OPERATION_LOG_TIME_LAYOUT = "2006-01-02 15:04:05"
 )
 
 var CBS_STORAGE_TYPE = []string{
@@ -28,3 +43,20 @@ var CBS_STORAGE_USAGE = []string{
 	CBS_STORAGE_USAGE_SYSTEM_DISK,
 	CBS_STORAGE_USAGE_DATA_DISK,
 }
+
+var CBS_CHARGE_TYPE = []string{
+	CBS_CHARGE_TYPE_PREPAID,
+	CBS_CHARGE_TYPE_POSTPAID_BY_HOUR,
+}
+
+var CBS_PRICE_OPERATION = []string{
+	CBS_PRICE_OPERATION_CREATE,
+	CBS_PRICE_OPERATION_RESIZE,
+	CBS_PRICE_OPERATION_RENEW,
+}
+
+var CBS_RENEW_FLAG = []string{
+	CBS_RENEW_FLAG_NOTIFY_AND_AUTO_RENEW,
+	CBS_RENEW_FLAG_NOTIFY_AND_MANUAL_RENEW,
+	CBS_RENEW_FLAG_DISABLE_NOTIFY_AND_MANUAL_RENEW,
+}
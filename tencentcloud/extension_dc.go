@@ -14,3 +14,33 @@ const (
 )
 
 var DC_ROUTE_TYPES = []string{DC_ROUTE_TYPE_BGP, DC_ROUTE_TYPE_STATIC}
+
+const (
+	DC_LINE_OPERATOR_CHINA_TELECOM          = "ChinaTelecom"
+	DC_LINE_OPERATOR_CHINA_MOBILE           = "ChinaMobile"
+	DC_LINE_OPERATOR_CHINA_UNICOM           = "ChinaUnicom"
+	DC_LINE_OPERATOR_IN_HOUSE_WIRING        = "In-houseWiring"
+	DC_LINE_OPERATOR_CHINA_OTHER            = "ChinaOther"
+	DC_LINE_OPERATOR_INTERNATIONAL_OPERATOR = "InternationalOperator"
+)
+
+var DC_LINE_OPERATORS = []string{DC_LINE_OPERATOR_CHINA_TELECOM, DC_LINE_OPERATOR_CHINA_MOBILE, DC_LINE_OPERATOR_CHINA_UNICOM,
+	DC_LINE_OPERATOR_IN_HOUSE_WIRING, DC_LINE_OPERATOR_CHINA_OTHER, DC_LINE_OPERATOR_INTERNATIONAL_OPERATOR}
+
+const (
+	DC_PORT_TYPE_100BASE_T   = "100Base-T"
+	DC_PORT_TYPE_1000BASE_T  = "1000Base-T"
+	DC_PORT_TYPE_1000BASE_LX = "1000Base-LX"
+	DC_PORT_TYPE_10GBASE_T   = "10GBase-T"
+	DC_PORT_TYPE_10GBASE_LR  = "10GBase-LR"
+)
+
+var DC_PORT_TYPES = []string{DC_PORT_TYPE_100BASE_T, DC_PORT_TYPE_1000BASE_T, DC_PORT_TYPE_1000BASE_LX,
+	DC_PORT_TYPE_10GBASE_T, DC_PORT_TYPE_10GBASE_LR}
+
+const (
+	DCX_SHARE_ACTION_ACCEPT = "ACCEPT"
+	DCX_SHARE_ACTION_REJECT = "REJECT"
+)
+
+var DCX_SHARE_ACTIONS = []string{DCX_SHARE_ACTION_ACCEPT, DCX_SHARE_ACTION_REJECT}
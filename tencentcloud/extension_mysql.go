@@ -47,6 +47,20 @@ var MYSQL_ROLE_MAP = map[int64]string{
 //mysql available period value
 var MYSQL_AVAILABLE_PERIOD = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36}
 
+//charge type used by price inquiry
+const (
+	MYSQL_CHARGE_TYPE_PREPAID  = "PRE_PAID"
+	MYSQL_CHARGE_TYPE_POSTPAID = "HOUR_PAID"
+)
+
+var MYSQL_INSTANCE_ROLES = []string{"master", "ro", "dr"}
+
+//readonly group mode, alone - system assigned; allinone - new group; join - existing group
+var MYSQL_RO_GROUP_MODES = []string{"alone", "allinone", "join"}
+
+//readonly group weight mode
+var MYSQL_RO_GROUP_WEIGHT_MODES = []string{"system", "custom"}
+
 var MYSQL_SUPPORTS_ENGINE = []string{"5.5", "5.6", "5.7"}
 
 //automatic renewal status code
@@ -17,6 +17,25 @@ var REDIS_NAMES = map[int64]string{
 	REDIS_VERSION_STANDALONE_REDIS:   "standalone_redis",
 }
 
+//redis editions which support sharding and replicas
+var REDIS_CLUSTER_NAMES = []string{
+	REDIS_NAMES[REDIS_VERSION_CLUSTER_REDIS],
+	REDIS_NAMES[REDIS_VERSION_CLUSTER_CKV],
+}
+
+//redis charge type
+const (
+	REDIS_CHARGE_TYPE_POSTPAID = "POSTPAID"
+	REDIS_CHARGE_TYPE_PREPAID  = "PREPAID"
+)
+
+var REDIS_CHARGE_TYPE_NAME = map[int64]string{
+	0: REDIS_CHARGE_TYPE_POSTPAID,
+	1: REDIS_CHARGE_TYPE_PREPAID,
+}
+
+var REDIS_AVAILABLE_PERIOD = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36}
+
 //redis status  https://cloud.tencent.com/document/product/239/20018
 const (
 	REDIS_STATUS_INIT       = 0
@@ -98,3 +117,50 @@ const (
 	REDIS_TASK_FAILED    = "failed"
 	REDIS_TASK_ERROR     = "error"
 )
+
+//https://cloud.tencent.com/document/api/239/20022#InstanceParamHistory
+const (
+	REDIS_PARAM_STATUS_MODIFYING = 1
+	REDIS_PARAM_STATUS_SUCCESS   = 2
+	REDIS_PARAM_STATUS_FAILED    = 3
+)
+
+var REDIS_PARAM_STATUS = map[int64]string{
+	REDIS_PARAM_STATUS_MODIFYING: "modifying",
+	REDIS_PARAM_STATUS_SUCCESS:   "success",
+	REDIS_PARAM_STATUS_FAILED:    "failed",
+}
+
+//https://cloud.tencent.com/document/api/239/20022#RedisBackupSet
+const (
+	REDIS_BACKUP_STATUS_LOCKED    = 1
+	REDIS_BACKUP_STATUS_NORMAL    = 2
+	REDIS_BACKUP_STATUS_EXPORTING = 3
+	REDIS_BACKUP_STATUS_EXPORTED  = 4
+	REDIS_BACKUP_STATUS_EXPIRED   = -1
+)
+
+var REDIS_BACKUP_STATUS = map[int64]string{
+	REDIS_BACKUP_STATUS_LOCKED:    "locked",
+	REDIS_BACKUP_STATUS_NORMAL:    "normal",
+	REDIS_BACKUP_STATUS_EXPORTING: "exporting",
+	REDIS_BACKUP_STATUS_EXPORTED:  "exported",
+	REDIS_BACKUP_STATUS_EXPIRED:   "expired",
+}
+
+const REDIS_BACKUP_TYPE_MANUAL = "manualBackupInstance"
+
+//role of a shard node  https://cloud.tencent.com/document/api/239/20022#InstanceClusterShard
+var REDIS_SHARD_ROLE = map[int64]string{
+	0: "master",
+	1: "slave",
+}
+
+const REDIS_OPERATION_STATUS_OK = "OK"
+
+//operations of ModifyNetworkConfig  https://cloud.tencent.com/document/api/239/30600
+const (
+	REDIS_NETWORK_CHANGE_VIP         = "changeVip"
+	REDIS_NETWORK_CHANGE_VPC         = "changeVpc"
+	REDIS_NETWORK_CHANGE_BASE_TO_VPC = "changeBaseToVpc"
+)
@@ -22,3 +22,16 @@ var ALL_GATE_WAY_TYPES = []string{GATE_WAY_TYPE_CVM,
 	GATE_WAY_TYPE_EIP,
 	GATE_WAY_TYPE_CCN,
 }
+
+/*
+ vpn gateway charge types
+ https://cloud.tencent.com/document/api/215/17514
+*/
+const VPN_CHARGE_TYPE_PREPAID = "PREPAID"
+const VPN_CHARGE_TYPE_POSTPAID_BY_HOUR = "POSTPAID_BY_HOUR"
+
+var VPN_CHARGE_TYPES = []string{VPN_CHARGE_TYPE_PREPAID,
+	VPN_CHARGE_TYPE_POSTPAID_BY_HOUR,
+}
+
+var VPN_AVAILABLE_BANDWIDTH = []int{5, 10, 20, 50, 100}
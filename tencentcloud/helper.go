@@ -2,6 +2,7 @@ package tencentcloud
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 
 	"strings"
@@ -138,3 +139,29 @@ func intToPointer(i int) *uint64 {
 	u := uint64(i)
 	return &u
 }
+
+// Normalize a JSON string by decoding and encoding it again, the keys of objects are sorted
+func normalizeJsonString(s string) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s, err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return s, err
+	}
+	return string(b), nil
+}
+
+// Suppress the diff of two JSON strings which are equivalent after being normalized
+func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldJson, err := normalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJson, err := normalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldJson == newJson
+}
@@ -20,29 +20,47 @@ provider "tencentcloud" {
 Resources List
 
 Data Sources
+  tencentcloud_as_scaling_activities
   tencentcloud_as_scaling_configs
   tencentcloud_as_scaling_groups
+  tencentcloud_as_scaling_instances
   tencentcloud_as_scaling_policies
   tencentcloud_availability_zones
+  tencentcloud_cbs_price
   tencentcloud_cbs_snapshots
   tencentcloud_cbs_storages
+  tencentcloud_cbs_disk_config_quota
+  tencentcloud_cbs_disk_operation_logs
+  tencentcloud_cbs_snapshot_operation_logs
+  tencentcloud_cbs_storage_snapshot_policies
   tencentcloud_ccn_bandwidth_limits
   tencentcloud_ccn_instances
   tencentcloud_container_cluster_instances
   tencentcloud_container_clusters
   tencentcloud_cos_bucket_object
   tencentcloud_cos_buckets
+  tencentcloud_cos_presigned_url
+  tencentcloud_dc_access_points
   tencentcloud_dc_instances
   tencentcloud_dcx_instances
   tencentcloud_eip
   tencentcloud_image
   tencentcloud_instance_types
   tencentcloud_mysql_backup_list
+  tencentcloud_mysql_binlogs
+  tencentcloud_mysql_databases
   tencentcloud_mysql_instance
   tencentcloud_mysql_parameter_list
+  tencentcloud_mysql_price
+  tencentcloud_mysql_slow_logs
+  tencentcloud_mysql_switch_records
+  tencentcloud_mysql_tables
   tencentcloud_mysql_zone_config
   tencentcloud_nats
+  tencentcloud_redis_backups
+  tencentcloud_redis_instance_shards
   tencentcloud_redis_instances
+  tencentcloud_redis_param_records
   tencentcloud_redis_zone_config
   tencentcloud_route_table
   tencentcloud_security_group
@@ -51,6 +69,7 @@ Data Sources
   tencentcloud_vpc_instances
   tencentcloud_vpc_route_tables
   tencentcloud_vpc_subnets
+  tencentcloud_vpn_gateway_price
 
 AS Resources
   tencentcloud_as_scaling_config
@@ -59,6 +78,7 @@ AS Resources
   tencentcloud_as_scaling_policy
   tencentcloud_as_schedule
   tencentcloud_as_lifecycle_hook
+  tencentcloud_as_complete_lifecycle
   tencentcloud_as_notification
 
 CBS Resources
@@ -66,6 +86,7 @@ CBS Resources
   tencentcloud_cbs_storage_attachment
   tencentcloud_cbs_snapshot
   tencentcloud_cbs_snapshot_policy
+  tencentcloud_cbs_snapshot_policy_attachment
 
 CCN Resources
   tencentcloud_ccn
@@ -78,10 +99,16 @@ Container Cluster Resources
 
 COS Resources
   tencentcloud_cos_bucket
+  tencentcloud_cos_bucket_domain
   tencentcloud_cos_bucket_object
+  tencentcloud_cos_bucket_object_copy
+  tencentcloud_cos_bucket_objects_sync
+  tencentcloud_cos_bucket_policy
 
 DC Resources
+  tencentcloud_dc_instance
   tencentcloud_dcx
+  tencentcloud_dcx_accepter
 
 CVM Resources
   tencentcloud_instance
@@ -96,6 +123,7 @@ LB Resources
 MySQL Resources
   tencentcloud_mysql_instance
   tencentcloud_mysql_readonly_instance
+  tencentcloud_mysql_dr_instance
   tencentcloud_mysql_account
   tencentcloud_mysql_account_privilege
   tencentcloud_mysql_backup_policy
@@ -103,6 +131,7 @@ MySQL Resources
 Redis Resources
   tencentcloud_redis_instance
   tencentcloud_redis_backup_config
+  tencentcloud_redis_backup
 
 VPC Resources
   tencentcloud_vpc
@@ -157,81 +186,110 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"tencentcloud_availability_zones":          dataSourceTencentCloudAvailabilityZones(),
-			"tencentcloud_eip":                         dataSourceTencentCloudEip(),
-			"tencentcloud_image":                       dataSourceTencentCloudSourceImages(),
-			"tencentcloud_instance_types":              dataSourceInstanceTypes(),
-			"tencentcloud_vpc":                         dataSourceTencentCloudVpc(),
-			"tencentcloud_subnet":                      dataSourceTencentCloudSubnet(),
-			"tencentcloud_route_table":                 dataSourceTencentCloudRouteTable(),
-			"tencentcloud_security_group":              dataSourceTencentCloudSecurityGroup(),
-			"tencentcloud_nats":                        dataSourceTencentCloudNats(),
-			"tencentcloud_container_clusters":          dataSourceTencentCloudContainerClusters(),
-			"tencentcloud_container_cluster_instances": dataSourceTencentCloudContainerClusterInstances(),
-			"tencentcloud_mysql_backup_list":           dataSourceTencentMysqlBackupList(),
-			"tencentcloud_mysql_zone_config":           dataSourceTencentMysqlZoneConfig(),
-			"tencentcloud_mysql_parameter_list":        dataSourceTencentCloudMysqlParameterList(),
-			"tencentcloud_mysql_instance":              dataSourceTencentCloudMysqlInstance(),
-			"tencentcloud_cos_bucket_object":           dataSourceTencentCloudCosBucketObject(),
-			"tencentcloud_cos_buckets":                 dataSourceTencentCloudCosBuckets(),
-			"tencentcloud_redis_zone_config":           dataSourceTencentRedisZoneConfig(),
-			"tencentcloud_redis_instances":             dataSourceTencentRedisInstances(),
-			"tencentcloud_as_scaling_configs":          dataSourceTencentCloudAsScalingConfigs(),
-			"tencentcloud_as_scaling_groups":           dataSourceTencentCloudAsScalingGroups(),
-			"tencentcloud_as_scaling_policies":         dataSourceTencentCloudAsScalingPolicies(),
-			"tencentcloud_vpc_instances":               dataSourceTencentCloudVpcInstances(),
-			"tencentcloud_vpc_subnets":                 dataSourceTencentCloudVpcSubnets(),
-			"tencentcloud_vpc_route_tables":            dataSourceTencentCloudVpcRouteTables(),
-			"tencentcloud_ccn_instances":               dataSourceTencentCloudCcnInstances(),
-			"tencentcloud_ccn_bandwidth_limits":        dataSourceTencentCloudCcnBandwidthLimits(),
-			"tencentcloud_cbs_storages":                dataSourceTencentCloudCbsStorages(),
-			"tencentcloud_cbs_snapshots":               dataSourceTencentCloudCbsSnapshots(),
-			"tencentcloud_dc_instances":                dataSourceTencentCloudDcInstances(),
-			"tencentcloud_dcx_instances":               dataSourceTencentCloudDcxInstances(),
+			"tencentcloud_availability_zones":            dataSourceTencentCloudAvailabilityZones(),
+			"tencentcloud_eip":                           dataSourceTencentCloudEip(),
+			"tencentcloud_image":                         dataSourceTencentCloudSourceImages(),
+			"tencentcloud_instance_types":                dataSourceInstanceTypes(),
+			"tencentcloud_vpc":                           dataSourceTencentCloudVpc(),
+			"tencentcloud_subnet":                        dataSourceTencentCloudSubnet(),
+			"tencentcloud_route_table":                   dataSourceTencentCloudRouteTable(),
+			"tencentcloud_security_group":                dataSourceTencentCloudSecurityGroup(),
+			"tencentcloud_nats":                          dataSourceTencentCloudNats(),
+			"tencentcloud_container_clusters":            dataSourceTencentCloudContainerClusters(),
+			"tencentcloud_container_cluster_instances":   dataSourceTencentCloudContainerClusterInstances(),
+			"tencentcloud_mysql_backup_list":             dataSourceTencentMysqlBackupList(),
+			"tencentcloud_mysql_zone_config":             dataSourceTencentMysqlZoneConfig(),
+			"tencentcloud_mysql_parameter_list":          dataSourceTencentCloudMysqlParameterList(),
+			"tencentcloud_mysql_instance":                dataSourceTencentCloudMysqlInstance(),
+			"tencentcloud_mysql_slow_logs":               dataSourceTencentCloudMysqlSlowLogs(),
+			"tencentcloud_mysql_binlogs":                 dataSourceTencentCloudMysqlBinlogs(),
+			"tencentcloud_mysql_databases":               dataSourceTencentCloudMysqlDatabases(),
+			"tencentcloud_mysql_tables":                  dataSourceTencentCloudMysqlTables(),
+			"tencentcloud_mysql_switch_records":          dataSourceTencentCloudMysqlSwitchRecords(),
+			"tencentcloud_mysql_price":                   dataSourceTencentCloudMysqlPrice(),
+			"tencentcloud_cos_bucket_object":             dataSourceTencentCloudCosBucketObject(),
+			"tencentcloud_cos_buckets":                   dataSourceTencentCloudCosBuckets(),
+			"tencentcloud_cos_presigned_url":             dataSourceTencentCloudCosPresignedUrl(),
+			"tencentcloud_redis_zone_config":             dataSourceTencentRedisZoneConfig(),
+			"tencentcloud_redis_instances":               dataSourceTencentRedisInstances(),
+			"tencentcloud_redis_param_records":           dataSourceTencentRedisParamRecords(),
+			"tencentcloud_redis_backups":                 dataSourceTencentRedisBackups(),
+			"tencentcloud_redis_instance_shards":         dataSourceTencentRedisInstanceShards(),
+			"tencentcloud_as_scaling_activities":         dataSourceTencentCloudAsScalingActivities(),
+			"tencentcloud_as_scaling_configs":            dataSourceTencentCloudAsScalingConfigs(),
+			"tencentcloud_as_scaling_groups":             dataSourceTencentCloudAsScalingGroups(),
+			"tencentcloud_as_scaling_instances":          dataSourceTencentCloudAsScalingInstances(),
+			"tencentcloud_as_scaling_policies":           dataSourceTencentCloudAsScalingPolicies(),
+			"tencentcloud_vpc_instances":                 dataSourceTencentCloudVpcInstances(),
+			"tencentcloud_vpc_subnets":                   dataSourceTencentCloudVpcSubnets(),
+			"tencentcloud_vpc_route_tables":              dataSourceTencentCloudVpcRouteTables(),
+			"tencentcloud_vpn_gateway_price":             dataSourceTencentCloudVpnGatewayPrice(),
+			"tencentcloud_ccn_instances":                 dataSourceTencentCloudCcnInstances(),
+			"tencentcloud_ccn_bandwidth_limits":          dataSourceTencentCloudCcnBandwidthLimits(),
+			"tencentcloud_cbs_storages":                  dataSourceTencentCloudCbsStorages(),
+			"tencentcloud_cbs_snapshots":                 dataSourceTencentCloudCbsSnapshots(),
+			"tencentcloud_cbs_disk_config_quota":         dataSourceTencentCloudCbsDiskConfigQuota(),
+			"tencentcloud_cbs_disk_operation_logs":       dataSourceTencentCloudCbsDiskOperationLogs(),
+			"tencentcloud_cbs_snapshot_operation_logs":   dataSourceTencentCloudCbsSnapshotOperationLogs(),
+			"tencentcloud_cbs_price":                     dataSourceTencentCloudCbsPrice(),
+			"tencentcloud_cbs_storage_snapshot_policies": dataSourceTencentCloudCbsStorageSnapshotPolicies(),
+			"tencentcloud_dc_access_points":              dataSourceTencentCloudDcAccessPoints(),
+			"tencentcloud_dc_instances":                  dataSourceTencentCloudDcInstances(),
+			"tencentcloud_dcx_instances":                 dataSourceTencentCloudDcxInstances(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"tencentcloud_alb_server_attachment":      resourceTencentCloudAlbServerAttachment(),
-			"tencentcloud_cbs_snapshot":               resourceTencentCloudCbsSnapshot(),
-			"tencentcloud_cbs_snapshot_policy":        resourceTencentCloudCbsSnapshotPolicy(),
-			"tencentcloud_cbs_storage":                resourceTencentCloudCbsStorage(),
-			"tencentcloud_cbs_storage_attachment":     resourceTencentCloudCbsStorageAttachment(),
-			"tencentcloud_container_cluster":          resourceTencentCloudContainerCluster(),
-			"tencentcloud_container_cluster_instance": resourceTencentCloudContainerClusterInstance(),
-			"tencentcloud_dnat":                       resourceTencentCloudDnat(),
-			"tencentcloud_eip":                        resourceTencentCloudEip(),
-			"tencentcloud_eip_association":            resourceTencentCloudEipAssociation(),
-			"tencentcloud_instance":                   resourceTencentCloudInstance(),
-			"tencentcloud_key_pair":                   resourceTencentCloudKeyPair(),
-			"tencentcloud_lb":                         resourceTencentCloudLB(),
-			"tencentcloud_nat_gateway":                resourceTencentCloudNatGateway(),
-			"tencentcloud_route_entry":                resourceTencentCloudRouteEntry(),
-			"tencentcloud_route_table_entry":          resourceTencentCloudVpcRouteEntry(),
-			"tencentcloud_route_table":                resourceTencentCloudVpcRouteTable(),
-			"tencentcloud_security_group":             resourceTencentCloudSecurityGroup(),
-			"tencentcloud_security_group_rule":        resourceTencentCloudSecurityGroupRule(),
-			"tencentcloud_subnet":                     resourceTencentCloudVpcSubnet(),
-			"tencentcloud_vpc":                        resourceTencentCloudVpcInstance(),
-			"tencentcloud_mysql_backup_policy":        resourceTencentCloudMysqlBackupPolicy(),
-			"tencentcloud_mysql_account":              resourceTencentCloudMysqlAccount(),
-			"tencentcloud_mysql_account_privilege":    resourceTencentCloudMysqlAccountPrivilege(),
-			"tencentcloud_mysql_instance":             resourceTencentCloudMysqlInstance(),
-			"tencentcloud_mysql_readonly_instance":    resourceTencentCloudMysqlReadonlyInstance(),
-			"tencentcloud_cos_bucket":                 resourceTencentCloudCosBucket(),
-			"tencentcloud_cos_bucket_object":          resourceTencentCloudCosBucketObject(),
-			"tencentcloud_redis_instance":             resourceTencentCloudRedisInstance(),
-			"tencentcloud_redis_backup_config":        resourceTencentCloudRedisBackupConfig(),
-			"tencentcloud_as_scaling_config":          resourceTencentCloudAsScalingConfig(),
-			"tencentcloud_as_scaling_group":           resourceTencentCloudAsScalingGroup(),
-			"tencentcloud_as_attachment":              resourceTencentCloudAsAttachment(),
-			"tencentcloud_as_scaling_policy":          resourceTencentCloudAsScalingPolicy(),
-			"tencentcloud_as_schedule":                resourceTencentCloudAsSchedule(),
-			"tencentcloud_as_lifecycle_hook":          resourceTencentCloudAsLifecycleHook(),
-			"tencentcloud_as_notification":            resourceTencentCloudAsNotification(),
-			"tencentcloud_ccn":                        resourceTencentCloudCcn(),
-			"tencentcloud_ccn_attachment":             resourceTencentCloudCcnAttachment(),
-			"tencentcloud_ccn_bandwidth_limit":        resourceTencentCloudCcnBandwidthLimit(),
-			"tencentcloud_dcx":                        resourceTencentCloudDcxInstance(),
+			"tencentcloud_alb_server_attachment":          resourceTencentCloudAlbServerAttachment(),
+			"tencentcloud_cbs_snapshot":                   resourceTencentCloudCbsSnapshot(),
+			"tencentcloud_cbs_snapshot_policy":            resourceTencentCloudCbsSnapshotPolicy(),
+			"tencentcloud_cbs_snapshot_policy_attachment": resourceTencentCloudCbsSnapshotPolicyAttachment(),
+			"tencentcloud_cbs_storage":                    resourceTencentCloudCbsStorage(),
+			"tencentcloud_cbs_storage_attachment":         resourceTencentCloudCbsStorageAttachment(),
+			"tencentcloud_container_cluster":              resourceTencentCloudContainerCluster(),
+			"tencentcloud_container_cluster_instance":     resourceTencentCloudContainerClusterInstance(),
+			"tencentcloud_dnat":                           resourceTencentCloudDnat(),
+			"tencentcloud_eip":                            resourceTencentCloudEip(),
+			"tencentcloud_eip_association":                resourceTencentCloudEipAssociation(),
+			"tencentcloud_instance":                       resourceTencentCloudInstance(),
+			"tencentcloud_key_pair":                       resourceTencentCloudKeyPair(),
+			"tencentcloud_lb":                             resourceTencentCloudLB(),
+			"tencentcloud_nat_gateway":                    resourceTencentCloudNatGateway(),
+			"tencentcloud_route_entry":                    resourceTencentCloudRouteEntry(),
+			"tencentcloud_route_table_entry":              resourceTencentCloudVpcRouteEntry(),
+			"tencentcloud_route_table":                    resourceTencentCloudVpcRouteTable(),
+			"tencentcloud_security_group":                 resourceTencentCloudSecurityGroup(),
+			"tencentcloud_security_group_rule":            resourceTencentCloudSecurityGroupRule(),
+			"tencentcloud_subnet":                         resourceTencentCloudVpcSubnet(),
+			"tencentcloud_vpc":                            resourceTencentCloudVpcInstance(),
+			"tencentcloud_mysql_backup_policy":            resourceTencentCloudMysqlBackupPolicy(),
+			"tencentcloud_mysql_account":                  resourceTencentCloudMysqlAccount(),
+			"tencentcloud_mysql_account_privilege":        resourceTencentCloudMysqlAccountPrivilege(),
+			"tencentcloud_mysql_instance":                 resourceTencentCloudMysqlInstance(),
+			"tencentcloud_mysql_readonly_instance":        resourceTencentCloudMysqlReadonlyInstance(),
+			"tencentcloud_mysql_dr_instance":              resourceTencentCloudMysqlDrInstance(),
+			"tencentcloud_cos_bucket":                     resourceTencentCloudCosBucket(),
+			"tencentcloud_cos_bucket_domain":              resourceTencentCloudCosBucketDomain(),
+			"tencentcloud_cos_bucket_object":              resourceTencentCloudCosBucketObject(),
+			"tencentcloud_cos_bucket_object_copy":         resourceTencentCloudCosBucketObjectCopy(),
+			"tencentcloud_cos_bucket_objects_sync":        resourceTencentCloudCosBucketObjectsSync(),
+			"tencentcloud_cos_bucket_policy":              resourceTencentCloudCosBucketPolicy(),
+			"tencentcloud_redis_instance":                 resourceTencentCloudRedisInstance(),
+			"tencentcloud_redis_backup_config":            resourceTencentCloudRedisBackupConfig(),
+			"tencentcloud_redis_backup":                   resourceTencentCloudRedisBackup(),
+			"tencentcloud_as_scaling_config":              resourceTencentCloudAsScalingConfig(),
+			"tencentcloud_as_scaling_group":               resourceTencentCloudAsScalingGroup(),
+			"tencentcloud_as_attachment":                  resourceTencentCloudAsAttachment(),
+			"tencentcloud_as_scaling_policy":              resourceTencentCloudAsScalingPolicy(),
+			"tencentcloud_as_schedule":                    resourceTencentCloudAsSchedule(),
+			"tencentcloud_as_lifecycle_hook":              resourceTencentCloudAsLifecycleHook(),
+			"tencentcloud_as_complete_lifecycle":          resourceTencentCloudAsCompleteLifecycle(),
+			"tencentcloud_as_notification":                resourceTencentCloudAsNotification(),
+			"tencentcloud_ccn":                            resourceTencentCloudCcn(),
+			"tencentcloud_ccn_attachment":                 resourceTencentCloudCcnAttachment(),
+			"tencentcloud_ccn_bandwidth_limit":            resourceTencentCloudCcnBandwidthLimit(),
+			"tencentcloud_dc_instance":                    resourceTencentCloudDcInstance(),
+			"tencentcloud_dcx":                            resourceTencentCloudDcxInstance(),
+			"tencentcloud_dcx_accepter":                   resourceTencentCloudDcxAccepter(),
 		},
 
 		ConfigureFunc: providerConfigure,
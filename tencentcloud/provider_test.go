@@ -1,6 +1,8 @@
 package tencentcloud
 
 import (
+	"testing"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-template/template"
@@ -28,3 +30,9 @@ func init() {
 	//	testAccProvidersWithTLS[k] = v
 	//}
 }
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -7,6 +7,7 @@ Example Usage
 resource "tencentcloud_as_attachment" "attachment" {
   scaling_group_id           = "sg-afasfa"
   instance_ids               = ["ins-01", "ins-02"]
+  protected_from_scale_in    = true
 }
 ```
 */
@@ -39,6 +40,12 @@ func resourceTencentCloudAsAttachment() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "ID list of CVM instances to be attached to the scaling group.",
 			},
+			"protected_from_scale_in": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether the attached instances are protected from being removed when the scaling group scales in. Default is false.",
+			},
 		},
 	}
 }
@@ -58,6 +65,13 @@ func resourceTencentCloudAsAttachmentCreate(d *schema.ResourceData, meta interfa
 	}
 	d.SetId(scalingGroupId)
 
+	if d.Get("protected_from_scale_in").(bool) {
+		err = asService.SetInstancesProtection(ctx, scalingGroupId, instanceIds, true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudAsAttachmentRead(d, meta)
 }
 func resourceTencentCloudAsAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -68,11 +82,23 @@ func resourceTencentCloudAsAttachmentRead(d *schema.ResourceData, meta interface
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	instanceIds, err := asService.DescribeAutoScalingAttachment(ctx, scalingGroupId)
+	instances, err := asService.DescribeAutoScalingInstances(ctx, scalingGroupId)
 	if err != nil {
 		return err
 	}
+	instanceIds := make([]string, 0, len(instances))
+	protected := true
+	for _, instance := range instances {
+		if *instance.CreationType != SCALING_GROUP_INSTANCE_CREATION_TYPE_MANUAL_ATTACHING {
+			continue
+		}
+		instanceIds = append(instanceIds, *instance.InstanceId)
+		if instance.ProtectedFromScaleIn == nil || !*instance.ProtectedFromScaleIn {
+			protected = false
+		}
+	}
 	d.Set("instance_ids", instanceIds)
+	d.Set("protected_from_scale_in", protected && len(instanceIds) > 0)
 
 	return nil
 }
@@ -81,6 +107,10 @@ func resourceTencentCloudAsAttachmentUpdate(d *schema.ResourceData, meta interfa
 	ctx := context.WithValue(context.TODO(), "logId", logId)
 
 	scalingGroupId := d.Id()
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	protected := d.Get("protected_from_scale_in").(bool)
 	if d.HasChange("instance_ids") {
 		old, new := d.GetChange("instance_ids")
 		oldInstances := old.(*schema.Set)
@@ -88,14 +118,17 @@ func resourceTencentCloudAsAttachmentUpdate(d *schema.ResourceData, meta interfa
 		remove := expandStringList(oldInstances.Difference(newInstances).List())
 		add := expandStringList(newInstances.Difference(oldInstances).List())
 
-		asService := AsService{
-			client: meta.(*TencentCloudClient).apiV3Conn,
-		}
 		if len(add) > 0 {
 			err := asService.AttachInstances(ctx, scalingGroupId, add)
 			if err != nil {
 				return err
 			}
+			if protected && !d.HasChange("protected_from_scale_in") {
+				err = asService.SetInstancesProtection(ctx, scalingGroupId, add, true)
+				if err != nil {
+					return err
+				}
+			}
 		}
 		if len(remove) > 0 {
 			err := asService.DetachInstances(ctx, scalingGroupId, remove)
@@ -105,6 +138,14 @@ func resourceTencentCloudAsAttachmentUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("protected_from_scale_in") {
+		instanceIds := expandStringList(d.Get("instance_ids").(*schema.Set).List())
+		err := asService.SetInstancesProtection(ctx, scalingGroupId, instanceIds, protected)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 func resourceTencentCloudAsAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
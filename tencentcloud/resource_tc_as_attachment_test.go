@@ -31,6 +31,15 @@ func TestAccTencentCloudAsAttachment(t *testing.T) {
 					testAccCheckAsAttachmentExists("tencentcloud_as_attachment.attachment"),
 					resource.TestCheckResourceAttrSet("tencentcloud_as_attachment.attachment", "scaling_group_id"),
 					resource.TestCheckResourceAttr("tencentcloud_as_attachment.attachment", "instance_ids.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_as_attachment.attachment", "protected_from_scale_in", "false"),
+				),
+			},
+			{
+				Config: testAccAsAttachment_protected(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsAttachmentExists("tencentcloud_as_attachment.attachment"),
+					resource.TestCheckResourceAttr("tencentcloud_as_attachment.attachment", "instance_ids.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_as_attachment.attachment", "protected_from_scale_in", "true"),
 				),
 			},
 		},
@@ -187,3 +196,60 @@ resource "tencentcloud_as_attachment" "attachment" {
 }
 `
 }
+
+func testAccAsAttachment_protected() string {
+	return `
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-attachment-config"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {	
+	scaling_group_name = "tf-as-attachment-group"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 5
+	min_size = 0
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+}
+
+resource "tencentcloud_instance" "cvm_instance" {
+	instance_name = "tf_as_instance"
+	availability_zone = "ap-guangzhou-3"
+	image_id      = "img-9qabwvbn"
+	instance_type = "SA1.SMALL1"
+	system_disk_type = "CLOUD_SSD"
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_id = "${tencentcloud_subnet.subnet.id}"
+}
+
+resource "tencentcloud_instance" "cvm_instance_1" {
+	instance_name = "tf_as_instance_1"
+	availability_zone = "ap-guangzhou-3"
+	image_id      = "img-9qabwvbn"
+	instance_type = "SA1.SMALL1"
+	system_disk_type = "CLOUD_SSD"
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_id = "${tencentcloud_subnet.subnet.id}"
+}
+
+resource "tencentcloud_as_attachment" "attachment" {
+	scaling_group_id = "${tencentcloud_as_scaling_group.scaling_group.id}"
+	instance_ids = ["${tencentcloud_instance.cvm_instance.id}", "${tencentcloud_instance.cvm_instance_1.id}"]
+	protected_from_scale_in = true
+}
+`
+}
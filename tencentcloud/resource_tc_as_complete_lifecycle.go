@@ -0,0 +1,97 @@
+/*
+Provides a resource to complete the lifecycle action of an AS (Auto scaling) instance which is suspended by a lifecycle hook.
+
+~> **NOTE:** The lifecycle action is completed only once when the resource is created, destroying the resource does nothing to the instance.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_as_complete_lifecycle" "complete_lifecycle" {
+	lifecycle_hook_id = "ash-8azjzxcl"
+	instance_id = "ins-nm2w3c12"
+	lifecycle_action_result = "CONTINUE"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTencentCloudAsCompleteLifecycle() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAsCompleteLifecycleCreate,
+		Read:   resourceTencentCloudAsCompleteLifecycleRead,
+		Delete: resourceTencentCloudAsCompleteLifecycleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"lifecycle_hook_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the lifecycle hook.",
+			},
+			"lifecycle_action_result": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"CONTINUE", "ABANDON"}),
+				Description:  "Result of the lifecycle action, and available values include CONTINUE and ABANDON.",
+			},
+			"instance_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"lifecycle_action_token"},
+				Description:   "ID of the instance suspended by the lifecycle hook, either `instance_id` or `lifecycle_action_token` must be specified.",
+			},
+			"lifecycle_action_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_id"},
+				Description:   "Token of the lifecycle action sent to the notification target, either `instance_id` or `lifecycle_action_token` must be specified.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudAsCompleteLifecycleCreate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	lifecycleHookId := d.Get("lifecycle_hook_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	actionToken := d.Get("lifecycle_action_token").(string)
+	if instanceId == "" && actionToken == "" {
+		return fmt.Errorf("either instance_id or lifecycle_action_token must be specified")
+	}
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := asService.CompleteLifecycleAction(ctx, lifecycleHookId, d.Get("lifecycle_action_result").(string), instanceId, actionToken)
+	if err != nil {
+		return err
+	}
+
+	if instanceId != "" {
+		d.SetId(lifecycleHookId + FILED_SP + instanceId)
+	} else {
+		d.SetId(lifecycleHookId + FILED_SP + dataResourceIdHash(actionToken))
+	}
+	return resourceTencentCloudAsCompleteLifecycleRead(d, meta)
+}
+
+// a completed lifecycle action can't be queried, so the state is kept as it is to avoid completing it again
+func resourceTencentCloudAsCompleteLifecycleRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceTencentCloudAsCompleteLifecycleDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,91 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTencentCloudAsCompleteLifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAsScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsCompleteLifecycle_group(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsLifecycleHookExists("tencentcloud_as_lifecycle_hook.lifecycle_hook"),
+				),
+			},
+			// the launched instance is suspended by the lifecycle hook
+			{
+				Config: testAccAsCompleteLifecycle_group(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "desired_capacity", "1"),
+				),
+			},
+			{
+				Config: testAccAsCompleteLifecycle_group(1) + testAccAsCompleteLifecycle,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("tencentcloud_as_complete_lifecycle.complete_lifecycle", "lifecycle_hook_id", "tencentcloud_as_lifecycle_hook.lifecycle_hook", "id"),
+					resource.TestCheckResourceAttrPair("tencentcloud_as_complete_lifecycle.complete_lifecycle", "instance_id", "data.tencentcloud_as_scaling_instances.instances", "instance_list.0.instance_id"),
+					resource.TestCheckResourceAttr("tencentcloud_as_complete_lifecycle.complete_lifecycle", "lifecycle_action_result", "CONTINUE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAsCompleteLifecycle_group(desiredCapacity int) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-configuration"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-scaling-group"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 1
+	min_size = 0
+	desired_capacity = %d
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+}
+
+resource "tencentcloud_as_lifecycle_hook" "lifecycle_hook" {
+	scaling_group_id = "${tencentcloud_as_scaling_group.scaling_group.id}"
+	lifecycle_hook_name = "tf-as-lifecycle-hook"
+	lifecycle_transition = "INSTANCE_LAUNCHING"
+	default_result = "ABANDON"
+	heartbeat_timeout = 3600
+}
+`, desiredCapacity)
+}
+
+const testAccAsCompleteLifecycle = `
+data "tencentcloud_as_scaling_instances" "instances" {
+	scaling_group_id = "${tencentcloud_as_scaling_group.scaling_group.id}"
+}
+
+resource "tencentcloud_as_complete_lifecycle" "complete_lifecycle" {
+	lifecycle_hook_id = "${tencentcloud_as_lifecycle_hook.lifecycle_hook.id}"
+	instance_id = "${data.tencentcloud_as_scaling_instances.instances.instance_list.0.instance_id}"
+	lifecycle_action_result = "CONTINUE"
+}
+`
@@ -16,6 +16,31 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
 	desired_capacity = 1
 	termination_policies = ["NEWEST_INSTANCE"]
 	retry_policy = "INCREMENTAL_INTERVALS"
+	status = "ENABLED"
+}
+```
+
+Roll out the updated image of the launch configuration to the running instances
+
+```hcl
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-scaling-group"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 4
+	min_size = 0
+	vpc_id = "vpc-3efmz0z"
+	subnet_ids = ["subnet-mc3egos"]
+	desired_capacity = 2
+	wait_for_capacity = true
+
+	instance_refresh {
+		batch_size = 1
+		min_healthy_percentage = 50
+		pause_time = 60
+		triggers = {
+			image_id = "${tencentcloud_as_scaling_config.launch_configuration.image_id}"
+		}
+	}
 }
 ```
 
@@ -33,6 +58,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 
@@ -183,11 +209,61 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 					SCALING_GROUP_RETRY_POLICY_INCREMENTAL_INTERVALS}),
 			},
 
-			// computed value
 			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(SCALING_GROUP_ENABLED_STATUS),
+				Description:  "Enabled status of a scaling group, and available values include ENABLED and DISABLED. A DISABLED scaling group does not run any scaling activity.",
+			},
+			"instance_refresh": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replaces the instances launched by an outdated launch configuration in batches when `configuration_id` or this block changes, such as after the image of the launch configuration is updated. Lifecycle hooks of the scaling group are waited out while instances are launched or removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerMin(1),
+							Description:  "Number of instances replaced in each batch. Default is 1.",
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      90,
+							ValidateFunc: validateIntegerInRange(0, 100),
+							Description:  "Percentage of `desired_capacity` that must stay in service and healthy during the refresh, new instances are launched before the old ones are removed when it can't be kept. Default is 90.",
+						},
+						"pause_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerMin(0),
+							Description:  "Time in seconds to wait between two batches. Default is 0.",
+						},
+						"triggers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Arbitrary map of values whose change starts a refresh, such as the `image_id` of the launch configuration.",
+						},
+					},
+				},
+			},
+			"wait_for_capacity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to wait until the scaling group has reached `desired_capacity` with in service and healthy instances after it is created or updated, the causes of failed scaling activities are returned if it can't. Default is false, and it takes no effect on a DISABLED scaling group.",
+			},
+
+			// computed value
+			"group_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Current status of a scaling group.",
+				Description: "Current status of a scaling group, such as NORMAL, CVM_ABNORMAL, LB_ABNORMAL, VPC_ABNORMAL and INSUFFICIENT_BALANCE.",
 			},
 			"instance_count": {
 				Type:        schema.TypeInt,
@@ -204,6 +280,12 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 
 func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	startTime := time.Now().UTC().Format(SCALING_GROUP_ACTIVITY_TIME_LAYOUT)
 	request := as.NewCreateAutoScalingGroupRequest()
 
 	request.AutoScalingGroupName = stringToPointer(d.Get("scaling_group_name").(string))
@@ -248,28 +330,12 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if v, ok := d.GetOk("forward_load_balancers"); ok {
-		forwardBalancers := v.([]interface{})
-		request.ForwardLoadBalancers = make([]*as.ForwardLoadBalancer, 0, len(forwardBalancers))
-		for _, v := range forwardBalancers {
-			vv := v.(map[string]interface{})
-			target := vv["target_attribute"].([]map[string]interface{})
-			forwardBalancer := as.ForwardLoadBalancer{
-				LoadBalancerId: stringToPointer(vv["load_balancer_id"].(string)),
-				ListenerId:     stringToPointer(vv["listener_id"].(string)),
-				LocationId:     stringToPointer(vv["location_id"].(string)),
-			}
-			forwardBalancer.TargetAttributes = make([]*as.TargetAttribute, 0, len(target))
-			for _, t := range target {
-				targetAttribute := as.TargetAttribute{
-					Port:   intToPointer(t["port"].(int)),
-					Weight: intToPointer(t["weight"].(int)),
-				}
-				forwardBalancer.TargetAttributes = append(forwardBalancer.TargetAttributes, &targetAttribute)
-			}
-
-			request.ForwardLoadBalancers = append(request.ForwardLoadBalancers, &forwardBalancer)
+	if v, ok := d.GetOk("forward_balancer_ids"); ok {
+		forwardBalancers, err := asScalingGroupExpandForwardBalancers(v.([]interface{}), meta)
+		if err != nil {
+			return err
 		}
+		request.ForwardLoadBalancers = forwardBalancers
 	}
 
 	if v, ok := d.GetOk("termination_policies"); ok {
@@ -295,6 +361,16 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 	}
 	d.SetId(*response.Response.AutoScalingGroupId)
 
+	if d.Get("status").(string) == SCALING_GROUP_ENABLED_STATUS_DISABLED {
+		if err := asService.DisableAutoScalingGroup(ctx, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if err := asScalingGroupWaitForCapacity(ctx, &asService, d, startTime); err != nil {
+		return err
+	}
+
 	return resourceTencentCloudAsScalingGroupRead(d, meta)
 }
 
@@ -313,7 +389,8 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 
 	d.Set("scaling_group_name", *scalingGroup.AutoScalingGroupName)
 	d.Set("configuration_id", *scalingGroup.LaunchConfigurationId)
-	d.Set("status", *scalingGroup.AutoScalingGroupStatus)
+	d.Set("status", *scalingGroup.EnabledStatus)
+	d.Set("group_status", *scalingGroup.AutoScalingGroupStatus)
 	d.Set("instance_count", *scalingGroup.InstanceCount)
 	d.Set("max_size", *scalingGroup.MaxSize)
 	d.Set("min_size", *scalingGroup.MinSize)
@@ -328,62 +405,66 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 	d.Set("retry_policy", *scalingGroup.RetryPolicy)
 	d.Set("create_time", *scalingGroup.CreatedTime)
 
-	if scalingGroup.ForwardLoadBalancerSet != nil && len(scalingGroup.ForwardLoadBalancerSet) > 0 {
-		forwardLoadBalancers := make([]map[string]interface{}, 0, len(scalingGroup.ForwardLoadBalancerSet))
-		for _, v := range scalingGroup.ForwardLoadBalancerSet {
-			targetAttributes := make([]map[string]interface{}, 0, len(v.TargetAttributes))
-			for _, vv := range v.TargetAttributes {
-				targetAttribute := map[string]interface{}{
-					"port":   *vv.Port,
-					"weight": *vv.Weight,
-				}
-				targetAttributes = append(targetAttributes, targetAttribute)
-			}
-			forwardLoadBalancer := map[string]interface{}{
-				"load_balancer_id":  *v.LoadBalancerId,
-				"listener_id":       *v.ListenerId,
-				"target_attributes": targetAttributes,
-				"location_id":       *v.LocationId,
-			}
-			forwardLoadBalancers = append(forwardLoadBalancers, forwardLoadBalancer)
-		}
-		d.Set("forward_load_balancers", forwardLoadBalancers)
-	}
+	d.Set("forward_balancer_ids", flattenAsForwardLoadBalancers(scalingGroup.ForwardLoadBalancerSet))
 
 	return nil
 }
 
 func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	startTime := time.Now().UTC().Format(SCALING_GROUP_ACTIVITY_TIME_LAYOUT)
 
+	// enable the group first so that the following changes can take effect
+	if d.HasChange("status") && d.Get("status").(string) == SCALING_GROUP_ENABLED_STATUS_ENABLED {
+		if err := asService.EnableAutoScalingGroup(ctx, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	groupChanged := false
 	request := as.NewModifyAutoScalingGroupRequest()
 	scalingGroupId := d.Id()
 	request.AutoScalingGroupId = &scalingGroupId
 	if d.HasChange("scaling_group_name") {
+		groupChanged = true
 		request.AutoScalingGroupName = stringToPointer(d.Get("scaling_group_name").(string))
 	}
+	// the launch configuration is modified before refreshing the instances, so the replacements use the new one
+	if d.HasChange("configuration_id") {
+		groupChanged = true
+		request.LaunchConfigurationId = stringToPointer(d.Get("configuration_id").(string))
+	}
 	if d.HasChange("max_size") {
+		groupChanged = true
 		request.MaxSize = intToPointer(d.Get("max_size").(int))
 	}
 	if d.HasChange("min_size") {
+		groupChanged = true
 		request.MinSize = intToPointer(d.Get("min_size").(int))
 	}
 	if d.HasChange("vpc_id") {
+		groupChanged = true
 		request.VpcId = stringToPointer(d.Get("vpc_id").(string))
 	}
 	if d.HasChange("project_id") {
+		groupChanged = true
 		request.ProjectId = intToPointer(d.Get("project_id").(int))
 	}
 	if d.HasChange("default_cooldown") {
+		groupChanged = true
 		request.DefaultCooldown = intToPointer(d.Get("default_cooldown").(int))
 	}
-	if d.HasChange("desired_capacity") {
-		request.DesiredCapacity = intToPointer(d.Get("desired_capacity").(int))
-	}
 	if d.HasChange("retry_policy") {
+		groupChanged = true
 		request.RetryPolicy = stringToPointer(d.Get("retry_policy").(string))
 	}
 	if d.HasChange("subnet_ids") {
+		groupChanged = true
 		subnetIds := d.Get("subnet_ids").([]interface{})
 		request.SubnetIds = make([]*string, 0, len(subnetIds))
 		for i := range subnetIds {
@@ -392,6 +473,7 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 	if d.HasChange("zones") {
+		groupChanged = true
 		zones := d.Get("zones").([]interface{})
 		request.Zones = make([]*string, 0, len(zones))
 		for i := range zones {
@@ -400,6 +482,7 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 	if d.HasChange("termination_policies") {
+		groupChanged = true
 		terminationPolicies := d.Get("termination_policies").([]interface{})
 		request.TerminationPolicies = make([]*string, 0, len(terminationPolicies))
 		for i := range terminationPolicies {
@@ -408,54 +491,43 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyAutoScalingGroup(request)
-	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+	// desired capacity is modified together with the size limits to keep them consistent,
+	// otherwise it is modified alone without touching the rest of the group
+	if groupChanged {
+		if d.HasChange("desired_capacity") {
+			request.DesiredCapacity = intToPointer(d.Get("desired_capacity").(int))
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyAutoScalingGroup(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	} else if d.HasChange("desired_capacity") {
+		if err := asService.ModifyDesiredCapacity(ctx, d.Id(), d.Get("desired_capacity").(int)); err != nil {
+			return err
+		}
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-	balancerChanged := false
+	// ModifyLoadBalancers overwrites all the bindings, and clears them when only the group ID is given
 	balancerRequest := as.NewModifyLoadBalancersRequest()
 	balancerRequest.AutoScalingGroupId = &scalingGroupId
-	if d.HasChange("load_balancer_ids") {
-		balancerChanged = true
+	if d.HasChange("load_balancer_ids") || d.HasChange("forward_balancer_ids") {
 		loadBalancerIds := d.Get("load_balancer_ids").([]interface{})
-		balancerRequest.LoadBalancerIds = make([]*string, 0, len(loadBalancerIds))
 		for i := range loadBalancerIds {
 			loadBalancerId := loadBalancerIds[i].(string)
 			balancerRequest.LoadBalancerIds = append(balancerRequest.LoadBalancerIds, &loadBalancerId)
 		}
-	}
-
-	if d.HasChange("forward_load_balancers") {
-		balancerChanged = true
-		forwardBalancers := d.Get("forward_load_balancers").([]interface{})
-		balancerRequest.ForwardLoadBalancers = make([]*as.ForwardLoadBalancer, 0, len(forwardBalancers))
-		for _, v := range forwardBalancers {
-			vv := v.(map[string]interface{})
-			target := vv["target_attribute"].([]map[string]interface{})
-			forwardBalancer := as.ForwardLoadBalancer{
-				LoadBalancerId: stringToPointer(vv["load_balancer_id"].(string)),
-				ListenerId:     stringToPointer(vv["listener_id"].(string)),
-				LocationId:     stringToPointer(vv["location_id"].(string)),
-			}
-			forwardBalancer.TargetAttributes = make([]*as.TargetAttribute, 0, len(target))
-			for _, t := range target {
-				targetAttribute := as.TargetAttribute{
-					Port:   intToPointer(t["port"].(int)),
-					Weight: intToPointer(t["weight"].(int)),
-				}
-				forwardBalancer.TargetAttributes = append(forwardBalancer.TargetAttributes, &targetAttribute)
+		if v := d.Get("forward_balancer_ids").([]interface{}); len(v) > 0 {
+			forwardBalancers, err := asScalingGroupExpandForwardBalancers(v, meta)
+			if err != nil {
+				return err
 			}
-
-			balancerRequest.ForwardLoadBalancers = append(balancerRequest.ForwardLoadBalancers, &forwardBalancer)
+			balancerRequest.ForwardLoadBalancers = forwardBalancers
 		}
-	}
 
-	if balancerChanged {
 		balancerResponse, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyLoadBalancers(balancerRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -466,9 +538,195 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 			logId, balancerRequest.GetAction(), balancerRequest.ToJsonString(), balancerResponse.ToJsonString())
 	}
 
+	if d.HasChange("configuration_id") || d.HasChange("instance_refresh") {
+		if err := asScalingGroupRefreshInstances(ctx, &asService, d); err != nil {
+			return err
+		}
+	}
+
+	// disable the group last so that the changes above, such as refreshing instances, can be done
+	if d.HasChange("status") && d.Get("status").(string) == SCALING_GROUP_ENABLED_STATUS_DISABLED {
+		if err := asService.DisableAutoScalingGroup(ctx, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if err := asScalingGroupWaitForCapacity(ctx, &asService, d, startTime); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// the listeners and locations are checked before binding, as ModifyLoadBalancers does not tell which one is wrong
+func asScalingGroupExpandForwardBalancers(list []interface{}, meta interface{}) ([]*as.ForwardLoadBalancer, error) {
+	forwardBalancers := make([]*as.ForwardLoadBalancer, 0, len(list))
+	for _, v := range list {
+		vv := v.(map[string]interface{})
+		loadBalancerId := vv["load_balancer_id"].(string)
+		listenerId := vv["listener_id"].(string)
+		locationId := vv["location_id"].(string)
+		if err := checkForwardLBListener(meta.(*TencentCloudClient).lbConn, loadBalancerId, listenerId, locationId); err != nil {
+			return nil, err
+		}
+
+		forwardBalancer := as.ForwardLoadBalancer{
+			LoadBalancerId: stringToPointer(loadBalancerId),
+			ListenerId:     stringToPointer(listenerId),
+		}
+		if locationId != "" {
+			forwardBalancer.LocationId = stringToPointer(locationId)
+		}
+		targets := vv["target_attribute"].([]interface{})
+		forwardBalancer.TargetAttributes = make([]*as.TargetAttribute, 0, len(targets))
+		for _, t := range targets {
+			target := t.(map[string]interface{})
+			targetAttribute := as.TargetAttribute{
+				Port:   intToPointer(target["port"].(int)),
+				Weight: intToPointer(target["weight"].(int)),
+			}
+			forwardBalancer.TargetAttributes = append(forwardBalancer.TargetAttributes, &targetAttribute)
+		}
+		forwardBalancers = append(forwardBalancers, &forwardBalancer)
+	}
+	return forwardBalancers, nil
+}
+
+func asScalingGroupWaitForCapacity(ctx context.Context, asService *AsService, d *schema.ResourceData, startTime string) error {
+	if !d.Get("wait_for_capacity").(bool) || d.Get("status").(string) == SCALING_GROUP_ENABLED_STATUS_DISABLED {
+		return nil
+	}
+
+	// desired_capacity may be left to the cloud, so the actual value is used
+	scalingGroup, err := asService.DescribeAutoScalingGroupById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	return asService.WaitForScalingGroupCapacity(ctx, d.Id(), int(*scalingGroup.DesiredCapacity), startTime, 10*time.Minute)
+}
+
+// replace the instances which are not launched by the current version of the launch configuration in batches,
+// manually attached instances are kept because they can't be launched again by the scaling group
+func asScalingGroupRefreshInstances(ctx context.Context, asService *AsService, d *schema.ResourceData) error {
+	refreshes := d.Get("instance_refresh").([]interface{})
+	if len(refreshes) < 1 || refreshes[0] == nil {
+		return nil
+	}
+	refresh := refreshes[0].(map[string]interface{})
+	batchSize := refresh["batch_size"].(int)
+	minHealthyPercentage := refresh["min_healthy_percentage"].(int)
+	pauseTime := refresh["pause_time"].(int)
+
+	scalingGroupId := d.Id()
+	startTime := time.Now().UTC().Format(SCALING_GROUP_ACTIVITY_TIME_LAYOUT)
+	scalingGroup, err := asService.DescribeAutoScalingGroupById(ctx, scalingGroupId)
+	if err != nil {
+		return err
+	}
+	if *scalingGroup.EnabledStatus == SCALING_GROUP_ENABLED_STATUS_DISABLED {
+		return fmt.Errorf("instances of the DISABLED scaling group %s can not be refreshed", scalingGroupId)
+	}
+	configurationId := *scalingGroup.LaunchConfigurationId
+	configuration, err := asService.DescribeLaunchConfigurationById(ctx, configurationId)
+	if err != nil {
+		return err
+	}
+
+	// instances stay in the lifecycle hooks until they are completed or timed out
+	timeout := 10 * time.Minute
+	lifecycleHooks, err := asService.DescribeLifecycleHookByFilter(ctx, scalingGroupId)
+	if err != nil {
+		return err
+	}
+	var heartbeatTimeout int64
+	for _, lifecycleHook := range lifecycleHooks {
+		if lifecycleHook.HeartbeatTimeout != nil && *lifecycleHook.HeartbeatTimeout > heartbeatTimeout {
+			heartbeatTimeout = *lifecycleHook.HeartbeatTimeout
+		}
+	}
+	timeout += time.Duration(heartbeatTimeout) * time.Second
+
+	desiredCapacity := int(*scalingGroup.DesiredCapacity)
+	maxSize := int(*scalingGroup.MaxSize)
+	minHealthy := (desiredCapacity*minHealthyPercentage + 99) / 100
+
+	// the batches are counted from the outdated instances found first, so a replacement which is still outdated
+	// fails the refresh instead of looping forever
+	maxBatches := -1
+	for batch := 0; ; batch++ {
+		instances, err := asService.DescribeAutoScalingInstances(ctx, scalingGroupId)
+		if err != nil {
+			return err
+		}
+		healthyCount := 0
+		outdatedIds := make([]string, 0)
+		for _, instance := range instances {
+			if pointerToString(instance.LifeCycleState) == SCALING_GROUP_INSTANCE_LIFE_CYCLE_STATE_IN_SERVICE &&
+				pointerToString(instance.HealthStatus) == SCALING_GROUP_INSTANCE_HEALTH_STATUS_HEALTHY {
+				healthyCount++
+			}
+			if pointerToString(instance.CreationType) != SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO_CREATION {
+				continue
+			}
+			if pointerToString(instance.LaunchConfigurationId) != configurationId ||
+				(instance.VersionNumber != nil && configuration.VersionNumber != nil && *instance.VersionNumber != *configuration.VersionNumber) {
+				outdatedIds = append(outdatedIds, pointerToString(instance.InstanceId))
+			}
+		}
+		if len(outdatedIds) == 0 {
+			return nil
+		}
+		if maxBatches < 0 {
+			maxBatches = (len(outdatedIds) + batchSize - 1) / batchSize
+		}
+		if batch >= maxBatches {
+			return fmt.Errorf("scaling group %s still has outdated instances %v after %d refresh batches",
+				scalingGroupId, outdatedIds, maxBatches)
+		}
+		if len(outdatedIds) > batchSize {
+			outdatedIds = outdatedIds[:batchSize]
+		}
+
+		if batch > 0 && pauseTime > 0 {
+			time.Sleep(time.Duration(pauseTime) * time.Second)
+		}
+		log.Printf("[DEBUG] scaling group %s refresh batch %d, instances %v", scalingGroupId, batch, outdatedIds)
+
+		// launch the replacements first if removing the batch breaks the minimum healthy instances
+		if healthyCount-len(outdatedIds) < minHealthy {
+			surgeCapacity := desiredCapacity + len(outdatedIds)
+			if surgeCapacity > maxSize {
+				return fmt.Errorf("scaling group %s needs %d instances to keep %d%% of them healthy during the refresh, which exceeds max_size %d",
+					scalingGroupId, surgeCapacity, minHealthyPercentage, maxSize)
+			}
+			if err := asService.ModifyDesiredCapacity(ctx, scalingGroupId, surgeCapacity); err != nil {
+				return err
+			}
+			if err := asService.WaitForScalingGroupCapacity(ctx, scalingGroupId, surgeCapacity, startTime, timeout); err != nil {
+				return err
+			}
+		}
+
+		if err := asService.RemoveInstances(ctx, scalingGroupId, outdatedIds, timeout); err != nil {
+			return err
+		}
+
+		// removing instances decreases the desired capacity, so it is restored to launch the replacements
+		scalingGroup, err = asService.DescribeAutoScalingGroupById(ctx, scalingGroupId)
+		if err != nil {
+			return err
+		}
+		if int(*scalingGroup.DesiredCapacity) != desiredCapacity {
+			if err := asService.ModifyDesiredCapacity(ctx, scalingGroupId, desiredCapacity); err != nil {
+				return err
+			}
+		}
+		if err := asService.WaitForScalingGroupCapacity(ctx, scalingGroupId, desiredCapacity, startTime, timeout); err != nil {
+			return err
+		}
+	}
+}
+
 func resourceTencentCloudAsScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
 	ctx := context.WithValue(context.TODO(), "logId", logId)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -66,7 +67,8 @@ func TestAccTencentCloudAsScalingGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "min_size", "0"),
 					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "vpc_id"),
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "subnet_ids.#", "1"),
-					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "status"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "group_status"),
 					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "create_time"),
 				),
 			},
@@ -101,8 +103,10 @@ func TestAccTencentCloudAsScalingGroup_full(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "termination_policies.#", "1"),
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "termination_policies.0", "NEWEST_INSTANCE"),
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "retry_policy", "INCREMENTAL_INTERVALS"),
-					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "status"),
-					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "instance_count"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "wait_for_capacity", "true"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_count", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "group_status"),
 					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "create_time"),
 				),
 			},
@@ -119,6 +123,98 @@ func TestAccTencentCloudAsScalingGroup_full(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "retry_policy", "IMMEDIATE_RETRY"),
 				),
 			},
+			{
+				Config: testAccAsScalingGroup_status(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "scaling_group_name", "tf-as-group-update"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "desired_capacity", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "status", "DISABLED"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_group.scaling_group", "group_status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudAsScalingGroup_instanceRefresh(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAsScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsScalingGroup_instanceRefresh("img-9qabwvbn", "launch_configuration"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_count", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_refresh.0.batch_size", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_refresh.0.min_healthy_percentage", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_refresh.0.pause_time", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_refresh.0.triggers.image_id", "img-9qabwvbn"),
+				),
+			},
+			{
+				Config: testAccAsScalingGroup_instanceRefresh("img-oikl1tzv", "launch_configuration"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					testAccCheckAsScalingGroupInstancesRefreshed("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_count", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_refresh.0.triggers.image_id", "img-oikl1tzv"),
+				),
+			},
+			// the replacements are launched by the new launch configuration
+			{
+				Config: testAccAsScalingGroup_instanceRefresh("img-oikl1tzv", "launch_configuration_update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					testAccCheckAsScalingGroupInstancesRefreshed("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttrPair("tencentcloud_as_scaling_group.scaling_group", "configuration_id", "tencentcloud_as_scaling_config.launch_configuration_update", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudAsScalingGroup_loadBalancer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAsScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsScalingGroup_loadBalancer("${tencentcloud_lb.classic.id}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "load_balancer_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("tencentcloud_as_scaling_group.scaling_group", "load_balancer_ids.0", "tencentcloud_lb.classic", "id"),
+				),
+			},
+			// the bindings are replaced in place
+			{
+				Config: testAccAsScalingGroup_loadBalancer("${tencentcloud_lb.classic_update.id}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "load_balancer_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("tencentcloud_as_scaling_group.scaling_group", "load_balancer_ids.0", "tencentcloud_lb.classic_update", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "forward_balancer_ids.#", "0"),
+				),
+			},
+			// the bindings are cleared in place
+			{
+				Config: testAccAsScalingGroup_loadBalancerBase + testAccAsScalingGroup_loadBalancerCleared,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "load_balancer_ids.#", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "forward_balancer_ids.#", "0"),
+				),
+			},
+			{
+				Config:      testAccAsScalingGroup_forwardBalancerInvalid(),
+				ExpectError: regexp.MustCompile("listener lbl-notexist is not found"),
+			},
 		},
 	})
 }
@@ -144,6 +240,38 @@ func testAccCheckAsScalingGroupExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAsScalingGroupInstancesRefreshed(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("auto scaling group %s is not found", n)
+		}
+		asService := AsService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+		config, err := asService.DescribeLaunchConfigurationById(ctx, rs.Primary.Attributes["configuration_id"])
+		if err != nil {
+			return err
+		}
+		instances, err := asService.DescribeAutoScalingInstances(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			if *instance.LaunchConfigurationId != rs.Primary.Attributes["configuration_id"] {
+				return fmt.Errorf("instance %s is launched by the launch configuration %s, expected %s",
+					*instance.InstanceId, *instance.LaunchConfigurationId, rs.Primary.Attributes["configuration_id"])
+			}
+			if *instance.VersionNumber != *config.VersionNumber {
+				return fmt.Errorf("instance %s is launched by the version %d of the launch configuration, expected %d",
+					*instance.InstanceId, *instance.VersionNumber, *config.VersionNumber)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckAsScalingGroupDestroy(s *terraform.State) error {
 	logId := GetLogId(nil)
 	ctx := context.WithValue(context.TODO(), "logId", logId)
@@ -227,6 +355,7 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
 	desired_capacity = 1
 	termination_policies = ["NEWEST_INSTANCE"]
 	retry_policy = "INCREMENTAL_INTERVALS"
+	wait_for_capacity = true
 }
 `
 }
@@ -266,3 +395,176 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
 }
 `
 }
+
+func testAccAsScalingGroup_status() string {
+	return `
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}	
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-configuration-full"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {	
+	scaling_group_name = "tf-as-group-update"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 2
+	min_size = 0
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+	project_id = 0
+	default_cooldown = 300
+	desired_capacity = 1
+	termination_policies = ["OLDEST_INSTANCE"]
+	retry_policy = "IMMEDIATE_RETRY"
+	status = "DISABLED"
+}
+`
+}
+
+func testAccAsScalingGroup_instanceRefresh(imageId, configuration string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-configuration-refresh"
+	image_id = "%s"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration_update" {
+	configuration_name = "tf-as-configuration-refresh-update"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-group-refresh"
+	configuration_id = "${tencentcloud_as_scaling_config.%s.id}"
+	max_size = 1
+	min_size = 0
+	desired_capacity = 1
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+	wait_for_capacity = true
+
+	instance_refresh {
+		batch_size = 1
+		min_healthy_percentage = 0
+		triggers = {
+			image_id = "${tencentcloud_as_scaling_config.launch_configuration.image_id}"
+		}
+	}
+}
+`, imageId, configuration)
+}
+
+const testAccAsScalingGroup_loadBalancerBase = `
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-configuration-lb"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_lb" "classic" {
+	type = "OPEN"
+	forward = "CLASSIC"
+	name = "tf-as-lb-classic"
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+}
+
+resource "tencentcloud_lb" "classic_update" {
+	type = "OPEN"
+	forward = "CLASSIC"
+	name = "tf-as-lb-classic-update"
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+}
+
+resource "tencentcloud_lb" "application" {
+	type = "OPEN"
+	forward = "APPLICATION"
+	name = "tf-as-lb-application"
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+}
+`
+
+func testAccAsScalingGroup_loadBalancer(loadBalancerId string) string {
+	return testAccAsScalingGroup_loadBalancerBase + fmt.Sprintf(`
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-group-lb"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 1
+	min_size = 0
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+	load_balancer_ids = ["%s"]
+}
+`, loadBalancerId)
+}
+
+const testAccAsScalingGroup_loadBalancerCleared = `
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-group-lb"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 1
+	min_size = 0
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+}
+`
+
+func testAccAsScalingGroup_forwardBalancerInvalid() string {
+	return testAccAsScalingGroup_loadBalancerBase + `
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-group-lb"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 1
+	min_size = 0
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+
+	forward_balancer_ids {
+		load_balancer_id = "${tencentcloud_lb.application.id}"
+		listener_id = "lbl-notexist"
+
+		target_attribute {
+			port = 80
+			weight = 10
+		}
+	}
+}
+`
+}
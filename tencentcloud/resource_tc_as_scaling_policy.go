@@ -16,6 +16,13 @@ resource "tencentcloud_as_scaling_policy" "scaling_policy" {
 	continuous_time = 10
 	statistic = "AVERAGE"
 	cooldown = 360
+
+	execute {
+		honor_cooldown = true
+		triggers = {
+			release = "20191019"
+		}
+	}
 }
 ```
 */
@@ -108,6 +115,32 @@ func resourceTencentCloudAsScalingPolicy() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "An ID group of users to be notified when an alarm is triggered.",
 			},
+			"execute": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Executes the policy on demand when this block is added or changed, and waits for the scaling activity to finish. Re-applying an unchanged block does not execute the policy again.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"honor_cooldown": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether to fail the execution when the scaling group is in the cooldown time. Default is false.",
+						},
+						"triggers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Arbitrary map of values whose change executes the policy again.",
+						},
+					},
+				},
+			},
+			"execute_activity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the scaling activity started by the last execution.",
+			},
 		},
 	}
 }
@@ -156,6 +189,10 @@ func resourceTencentCloudAsScalingPolicyCreate(d *schema.ResourceData, meta inte
 	}
 	d.SetId(*response.Response.AutoScalingPolicyId)
 
+	if err := asScalingPolicyExecute(d, meta); err != nil {
+		return err
+	}
+
 	return resourceTencentCloudAsScalingPolicyRead(d, meta)
 }
 
@@ -193,42 +230,54 @@ func resourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta interf
 func resourceTencentCloudAsScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
 
+	policyChanged := false
 	request := as.NewModifyScalingPolicyRequest()
 	scalingPolicyId := d.Id()
 	request.AutoScalingPolicyId = &scalingPolicyId
 	if d.HasChange("policy_name") {
+		policyChanged = true
 		request.ScalingPolicyName = stringToPointer(d.Get("policy_name").(string))
 	}
 	if d.HasChange("adjustment_type") {
+		policyChanged = true
 		request.AdjustmentType = stringToPointer(d.Get("adjustment_type").(string))
 	}
 	if d.HasChange("adjustment_value") {
+		policyChanged = true
 		adjustmentValue := int64(d.Get("adjustment_value").(int))
 		request.AdjustmentValue = &adjustmentValue
 	}
 	request.MetricAlarm = &as.MetricAlarm{}
 	if d.HasChange("comparison_operator") {
+		policyChanged = true
 		request.MetricAlarm.ComparisonOperator = stringToPointer(d.Get("comparison_operator").(string))
 	}
 	if d.HasChange("metric_name") {
+		policyChanged = true
 		request.MetricAlarm.MetricName = stringToPointer(d.Get("metric_name").(string))
 	}
 	if d.HasChange("threshold") {
+		policyChanged = true
 		request.MetricAlarm.Threshold = intToPointer(d.Get("threshold").(int))
 	}
 	if d.HasChange("period") {
+		policyChanged = true
 		request.MetricAlarm.Period = intToPointer(d.Get("period").(int))
 	}
 	if d.HasChange("continuous_time") {
+		policyChanged = true
 		request.MetricAlarm.ContinuousTime = intToPointer(d.Get("continuous_time").(int))
 	}
 	if d.HasChange("statistic") {
+		policyChanged = true
 		request.MetricAlarm.Statistic = stringToPointer(d.Get("statistic").(string))
 	}
 	if d.HasChange("cooldown") {
+		policyChanged = true
 		request.Cooldown = intToPointer(d.Get("cooldown").(int))
 	}
 	if d.HasChange("notification_user_group_ids") {
+		policyChanged = true
 		notificationUserGroupIds := d.Get("notification_user_group_ids").([]interface{})
 		request.NotificationUserGroupIds = make([]*string, 0, len(notificationUserGroupIds))
 		for _, value := range notificationUserGroupIds {
@@ -236,14 +285,46 @@ func resourceTencentCloudAsScalingPolicyUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyScalingPolicy(request)
+	if policyChanged {
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyScalingPolicy(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	}
+
+	if d.HasChange("execute") {
+		if err := asScalingPolicyExecute(d, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func asScalingPolicyExecute(d *schema.ResourceData, meta interface{}) error {
+	executes := d.Get("execute").([]interface{})
+	if len(executes) < 1 {
+		return nil
+	}
+	honorCooldown := false
+	if executes[0] != nil {
+		honorCooldown = executes[0].(map[string]interface{})["honor_cooldown"].(bool)
+	}
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	activityId, err := asService.ExecuteScalingPolicy(ctx, d.Id(), honorCooldown)
 	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
 		return err
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	d.Set("execute_activity_id", activityId)
 	return nil
 }
 
@@ -53,6 +53,35 @@ func TestAccTencentCloudAsScalingPolicy(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudAsScalingPolicy_execute(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAsScalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsScalingPolicy_execute(1, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingPolicyExists("tencentcloud_as_scaling_policy.scaling_policy"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_policy.scaling_policy", "execute.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_policy.scaling_policy", "execute.0.honor_cooldown", "false"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_policy.scaling_policy", "execute.0.triggers.run", "1"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_policy.scaling_policy", "execute_activity_id"),
+				),
+			},
+			{
+				Config: testAccAsScalingPolicy_execute(0, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingPolicyExists("tencentcloud_as_scaling_policy.scaling_policy"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_policy.scaling_policy", "adjustment_value", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_policy.scaling_policy", "execute.0.triggers.run", "2"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_scaling_policy.scaling_policy", "execute_activity_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAsScalingPolicyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := GetLogId(nil)
@@ -183,3 +212,56 @@ resource "tencentcloud_as_scaling_policy" "scaling_policy" {
 }
 `
 }
+
+func testAccAsScalingPolicy_execute(adjustmentValue int, run string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_vpc" "vpc" {
+	name = "tf-as-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	name = "tf-as-subnet"
+	cidr_block = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+	configuration_name = "tf-as-configuration"
+	image_id = "img-9qabwvbn"
+	instance_types = ["SA1.SMALL1"]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+	scaling_group_name = "tf-as-scaling-group"
+	configuration_id = "${tencentcloud_as_scaling_config.launch_configuration.id}"
+	max_size = 1
+	min_size = 0
+	vpc_id = "${tencentcloud_vpc.vpc.id}"
+	subnet_ids = ["${tencentcloud_subnet.subnet.id}"]
+
+	lifecycle {
+		ignore_changes = ["desired_capacity"]
+	}
+}
+
+resource "tencentcloud_as_scaling_policy" "scaling_policy" {
+	scaling_group_id = "${tencentcloud_as_scaling_group.scaling_group.id}"
+	policy_name = "tf-as-scaling-policy"
+	adjustment_type = "EXACT_CAPACITY"
+	adjustment_value = %d
+	comparison_operator = "GREATER_THAN"
+	metric_name = "CPU_UTILIZATION"
+	threshold = 80
+	period = 300
+	continuous_time = 10
+
+	execute {
+		triggers = {
+			run = "%s"
+		}
+	}
+}
+`, adjustmentValue, run)
+}
@@ -0,0 +1,126 @@
+/*
+Provides a CBS snapshot policy attachment resource.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_cbs_snapshot_policy_attachment" "foo" {
+  storage_id         = "disk-kdt0sq6m"
+  snapshot_policy_id = "asp-jliex1tn"
+}
+```
+
+Import
+
+CBS snapshot policy attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cbs_snapshot_policy_attachment.foo disk-kdt0sq6m#asp-jliex1tn
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTencentCloudCbsSnapshotPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCbsSnapshotPolicyAttachmentCreate,
+		Read:   resourceTencentCloudCbsSnapshotPolicyAttachmentRead,
+		Delete: resourceTencentCloudCbsSnapshotPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the CBS to be bound.",
+			},
+			"snapshot_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the snapshot policy.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCbsSnapshotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	storageId := d.Get("storage_id").(string)
+	policyId := d.Get("snapshot_policy_id").(string)
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := cbsService.BindAutoSnapshotPolicy(ctx, policyId, storageId)
+	if err != nil {
+		return err
+	}
+	d.SetId(storageId + FILED_SP + policyId)
+
+	return resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d, meta)
+}
+
+func resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	items := strings.Split(d.Id(), FILED_SP)
+	if len(items) != 2 {
+		return fmt.Errorf("id %s of cbs snapshot policy attachment is broken", d.Id())
+	}
+	storageId, policyId := items[0], items[1]
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	policy, err := cbsService.DescribeAttachedSnapshotPolicy(ctx, storageId, policyId)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		log.Printf("[DEBUG]%s, disk id %s is not bound to snapshot policy %s", logId, storageId, policyId)
+		d.SetId("")
+		return nil
+	}
+	d.Set("storage_id", storageId)
+	d.Set("snapshot_policy_id", policyId)
+	return nil
+}
+
+func resourceTencentCloudCbsSnapshotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	items := strings.Split(d.Id(), FILED_SP)
+	if len(items) != 2 {
+		return fmt.Errorf("id %s of cbs snapshot policy attachment is broken", d.Id())
+	}
+	storageId, policyId := items[0], items[1]
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	policy, err := cbsService.DescribeAttachedSnapshotPolicy(ctx, storageId, policyId)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		log.Printf("[DEBUG]%s, disk id %s is not bound to snapshot policy %s", logId, storageId, policyId)
+		return nil
+	}
+	return cbsService.UnbindAutoSnapshotPolicy(ctx, policyId, storageId)
+}
@@ -0,0 +1,112 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudCbsSnapshotPolicyAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCbsSnapshotPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsSnapshotPolicyAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCbsSnapshotPolicyAttachmentExists("tencentcloud_cbs_snapshot_policy_attachment.attachment"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_policy_attachment.attachment", "storage_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_policy_attachment.attachment", "snapshot_policy_id"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_cbs_snapshot_policy_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCbsSnapshotPolicyAttachmentDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cbsService := CbsService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_cbs_snapshot_policy_attachment" {
+			continue
+		}
+		items := strings.Split(rs.Primary.ID, FILED_SP)
+		if len(items) != 2 {
+			return fmt.Errorf("id %s of cbs snapshot policy attachment is broken", rs.Primary.ID)
+		}
+		policy, err := cbsService.DescribeAttachedSnapshotPolicy(ctx, items[0], items[1])
+		// the storage may be destroyed together with the attachment
+		if err != nil {
+			continue
+		}
+		if policy != nil {
+			return fmt.Errorf("cbs snapshot policy attachment still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckCbsSnapshotPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("cbs snapshot policy attachment %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("cbs snapshot policy attachment id is not set")
+		}
+		items := strings.Split(rs.Primary.ID, FILED_SP)
+		if len(items) != 2 {
+			return fmt.Errorf("id %s of cbs snapshot policy attachment is broken", rs.Primary.ID)
+		}
+		cbsService := CbsService{
+			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+		}
+		policy, err := cbsService.DescribeAttachedSnapshotPolicy(ctx, items[0], items[1])
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("cbs snapshot policy attachment not exists")
+		}
+		return nil
+	}
+}
+
+const testAccCbsSnapshotPolicyAttachmentConfig = `
+resource "tencentcloud_cbs_storage" "foo" {
+  availability_zone = "ap-guangzhou-3"
+  storage_size      = 50
+  storage_type      = "CLOUD_PREMIUM"
+  storage_name      = "tf-test-storage"
+}
+
+resource "tencentcloud_cbs_snapshot_policy" "policy" {
+  snapshot_policy_name = "tf-test-snapshot-policy"
+  repeat_weekdays      = [0, 3]
+  repeat_hours         = [0]
+  retention_days       = 30
+}
+
+resource "tencentcloud_cbs_snapshot_policy_attachment" "attachment" {
+  storage_id         = "${tencentcloud_cbs_storage.foo.id}"
+  snapshot_policy_id = "${tencentcloud_cbs_snapshot_policy.policy.id}"
+}
+`
@@ -17,6 +17,20 @@ resource "tencentcloud_cbs_storage" "storage" {
 }
 ```
 
+Using prepaid charge type
+
+```hcl
+resource "tencentcloud_cbs_storage" "prepaid" {
+  storage_name      = "mystorage"
+  storage_type      = "CLOUD_PREMIUM"
+  storage_size      = 100
+  availability_zone = "ap-guangzhou-3"
+  charge_type       = "PREPAID"
+  period            = 1
+  renew_flag        = "NOTIFY_AND_AUTO_RENEW"
+}
+```
+
 Import
 
 CBS storage can be imported using the id, e.g.
@@ -47,6 +61,7 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceTencentCloudCbsStorageCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -62,11 +77,26 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 				ValidateFunc: validateIntegerInRange(10, 16000),
 				Description:  "Volume of CBS.",
 			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      CBS_CHARGE_TYPE_POSTPAID_BY_HOUR,
+				ValidateFunc: validateAllowedStringValue(CBS_CHARGE_TYPE),
+				Description:  "The charge type of CBS, and available values include PREPAID and POSTPAID_BY_HOUR. Default is POSTPAID_BY_HOUR.",
+			},
 			"period": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validateIntegerInRange(1, 36),
-				Description:  "The purchased usage period of CBS, and value range [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36].",
+				Description:  "The purchased usage period of CBS, and value range [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36]. It is required when charge_type is PREPAID, and increasing it renews the CBS for the increased months, except for the first value set after importing.",
+			},
+			"renew_flag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(CBS_RENEW_FLAG),
+				Description:  "Auto renew flag of a PREPAID CBS, and available values include NOTIFY_AND_AUTO_RENEW, NOTIFY_AND_MANUAL_RENEW and DISABLE_NOTIFY_AND_MANUAL_RENEW. It can only be set when charge_type is PREPAID.",
 			},
 			"availability_zone": {
 				Type:        schema.TypeString,
@@ -103,6 +133,16 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 				Optional:    true,
 				Description: "The available tags within this CBS.",
 			},
+			"auto_snapshot_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the snapshot policy to bind the CBS to. Do not use it together with `tencentcloud_cbs_snapshot_policy_attachment` for the same CBS.",
+			},
+			"max_price": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "The maximum price (in CNY) acceptable for the CBS. If set, the price is inquired before creating, resizing or renewing the CBS and the operation fails when the discounted price is higher than this value. It is the hourly price when charge_type is POSTPAID_BY_HOUR, and the price to pay for the operation when charge_type is PREPAID.",
+			},
 
 			// computed
 			"storage_status": {
@@ -115,12 +155,137 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 				Computed:    true,
 				Description: "Indicates whether the CBS is mounted the CVM.",
 			},
+			"deadline_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of a PREPAID CBS.",
+			},
 		},
 	}
 }
 
+func resourceTencentCloudCbsStorageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// only a prepaid storage has a renew flag to modify
+	if d.HasChange("renew_flag") && d.Get("renew_flag").(string) != "" &&
+		d.Get("charge_type").(string) != CBS_CHARGE_TYPE_PREPAID {
+		return fmt.Errorf("renew_flag can only be set when charge_type is %s", CBS_CHARGE_TYPE_PREPAID)
+	}
+	return cbsStorageCheckConfigQuota(d, meta)
+}
+
+// validate storage_type and storage_size against the configs the zone supports at plan time
+func cbsStorageCheckConfigQuota(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("storage_size") {
+		return nil
+	}
+	zone := d.Get("availability_zone").(string)
+	storageType := d.Get("storage_type").(string)
+	chargeType := d.Get("charge_type").(string)
+	storageSize := d.Get("storage_size").(int)
+	if zone == "" || storageType == "" || storageSize == 0 {
+		return nil
+	}
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	configs, err := cbsService.DescribeDiskConfigQuota(ctx, []string{zone}, chargeType, []string{storageType}, CBS_STORAGE_USAGE_DATA_DISK)
+	if err != nil {
+		return err
+	}
+	for _, config := range configs {
+		if config.Available == nil || !*config.Available {
+			continue
+		}
+		if pointerToString(config.DiskType) != storageType || pointerToString(config.Zone) != zone {
+			continue
+		}
+		if config.MinDiskSize != nil && uint64(storageSize) < *config.MinDiskSize {
+			return fmt.Errorf("storage_size %d of %s in %s must not be less than %d", storageSize, storageType, zone, *config.MinDiskSize)
+		}
+		if config.MaxDiskSize != nil && uint64(storageSize) > *config.MaxDiskSize {
+			return fmt.Errorf("storage_size %d of %s in %s must not be greater than %d", storageSize, storageType, zone, *config.MaxDiskSize)
+		}
+		return nil
+	}
+	return fmt.Errorf("storage_type %s with charge_type %s is not available in %s", storageType, chargeType, zone)
+}
+
+func cbsStorageCheckMaxPrice(ctx context.Context, d *schema.ResourceData, meta interface{}, operation string) error {
+	maxPrice, ok := d.GetOk("max_price")
+	if !ok {
+		return nil
+	}
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	chargeType := d.Get("charge_type").(string)
+	storageSize := d.Get("storage_size").(int)
+
+	var discountPrice *float64
+	switch {
+	case chargeType == CBS_CHARGE_TYPE_PREPAID && operation == CBS_PRICE_OPERATION_RESIZE:
+		price, err := cbsService.InquiryPriceResizeDisk(ctx, d.Id(), storageSize)
+		if err != nil {
+			return err
+		}
+		if price != nil {
+			discountPrice = price.DiscountPrice
+		}
+	case chargeType == CBS_CHARGE_TYPE_PREPAID && operation == CBS_PRICE_OPERATION_RENEW:
+		old, new := d.GetChange("period")
+		price, err := cbsService.InquiryPriceRenewDisks(ctx, d.Id(), new.(int)-old.(int))
+		if err != nil {
+			return err
+		}
+		if price != nil {
+			discountPrice = price.DiscountPrice
+		}
+	case chargeType == CBS_CHARGE_TYPE_PREPAID:
+		price, err := cbsService.InquiryPriceCreateDisks(ctx, d.Get("storage_type").(string), storageSize,
+			CBS_CHARGE_TYPE_PREPAID, d.Get("period").(int), d.Get("project_id").(int))
+		if err != nil {
+			return err
+		}
+		if price != nil {
+			discountPrice = price.DiscountPrice
+		}
+	case operation == CBS_PRICE_OPERATION_RENEW:
+		// a postpaid storage is never renewed
+		return nil
+	default:
+		// the hourly price of a postpaid storage only depends on its size, so resizing is inquired as creating
+		price, err := cbsService.InquiryPriceCreateDisks(ctx, d.Get("storage_type").(string), storageSize,
+			CBS_CHARGE_TYPE_POSTPAID_BY_HOUR, 0, d.Get("project_id").(int))
+		if err != nil {
+			return err
+		}
+		if price != nil {
+			discountPrice = price.UnitPriceDiscount
+		}
+	}
+
+	if discountPrice == nil {
+		return fmt.Errorf("the price of cbs storage to %s is not returned by inquiry", operation)
+	}
+	if *discountPrice > maxPrice.(float64) {
+		return fmt.Errorf("the inquired price %v of cbs storage to %s is higher than max_price %v", *discountPrice, operation, maxPrice.(float64))
+	}
+	return nil
+}
+
 func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	if err := cbsStorageCheckMaxPrice(ctx, d, meta, CBS_PRICE_OPERATION_CREATE); err != nil {
+		return err
+	}
+
 	request := cbs.NewCreateDisksRequest()
 
 	request.DiskName = stringToPointer(d.Get("storage_name").(string))
@@ -149,7 +314,20 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	request.DiskChargeType = stringToPointer("POSTPAID_BY_HOUR")
+	chargeType := d.Get("charge_type").(string)
+	request.DiskChargeType = &chargeType
+	if chargeType == CBS_CHARGE_TYPE_PREPAID {
+		period, ok := d.GetOk("period")
+		if !ok {
+			return fmt.Errorf("period is required when charge_type is %s", CBS_CHARGE_TYPE_PREPAID)
+		}
+		request.DiskChargePrepaid = &cbs.DiskChargePrepaid{
+			Period: intToPointer(period.(int)),
+		}
+		if v, ok := d.GetOk("renew_flag"); ok {
+			request.DiskChargePrepaid.RenewFlag = stringToPointer(v.(string))
+		}
+	}
 
 	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCbsClient().CreateDisks(request)
 	if err != nil {
@@ -168,6 +346,16 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 	// must wait for finishing creating disk
 	time.Sleep(3 * time.Second)
 
+	if v, ok := d.GetOk("auto_snapshot_policy_id"); ok {
+		cbsService := CbsService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		err = cbsService.BindAutoSnapshotPolicy(ctx, v.(string), d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudCbsStorageRead(d, meta)
 }
 
@@ -193,6 +381,24 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 	d.Set("tags", flattenCbsTagsMapping(storage.Tags))
 	d.Set("storage_status", storage.DiskState)
 	d.Set("attached", storage.Attached)
+	d.Set("charge_type", storage.DiskChargeType)
+	if storage.RenewFlag != nil {
+		d.Set("renew_flag", storage.RenewFlag)
+	}
+	if storage.DeadlineTime != nil {
+		d.Set("deadline_time", storage.DeadlineTime)
+	}
+
+	// only the configured policy is checked, the others may be bound by tencentcloud_cbs_snapshot_policy_attachment
+	if policyId := d.Get("auto_snapshot_policy_id").(string); policyId != "" {
+		policy, err := cbsService.DescribeAttachedSnapshotPolicy(ctx, storageId, policyId)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			d.Set("auto_snapshot_policy_id", "")
+		}
+	}
 
 	return nil
 }
@@ -239,6 +445,9 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		if oldValue > newValue {
 			return fmt.Errorf("storage size must be greater than current storage size")
 		}
+		if err := cbsStorageCheckMaxPrice(ctx, d, meta, CBS_PRICE_OPERATION_RESIZE); err != nil {
+			return err
+		}
 
 		err := cbsService.ResizeDisk(ctx, storageId, newValue)
 		if err != nil {
@@ -284,6 +493,52 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		d.SetPartial("snapshot_id")
 	}
 
+	// period is not returned by the api, so an imported storage has none in state,
+	// and the configured value is only recorded instead of renewing the storage for it
+	if d.HasChange("period") && d.Get("charge_type").(string) == CBS_CHARGE_TYPE_PREPAID {
+		old, new := d.GetChange("period")
+		oldValue := old.(int)
+		newValue := new.(int)
+		if oldValue > 0 {
+			if oldValue > newValue {
+				return fmt.Errorf("period of prepaid storage can only increase")
+			}
+			if err := cbsStorageCheckMaxPrice(ctx, d, meta, CBS_PRICE_OPERATION_RENEW); err != nil {
+				return err
+			}
+			err := cbsService.RenewDisk(ctx, storageId, newValue-oldValue)
+			if err != nil {
+				return err
+			}
+		}
+		d.SetPartial("period")
+	}
+
+	if d.HasChange("renew_flag") {
+		err := cbsService.ModifyDisksRenewFlag(ctx, storageId, d.Get("renew_flag").(string))
+		if err != nil {
+			return err
+		}
+		d.SetPartial("renew_flag")
+	}
+
+	if d.HasChange("auto_snapshot_policy_id") {
+		old, new := d.GetChange("auto_snapshot_policy_id")
+		if oldPolicyId := old.(string); oldPolicyId != "" {
+			err := cbsService.UnbindAutoSnapshotPolicy(ctx, oldPolicyId, storageId)
+			if err != nil {
+				return err
+			}
+		}
+		if newPolicyId := new.(string); newPolicyId != "" {
+			err := cbsService.BindAutoSnapshotPolicy(ctx, newPolicyId, storageId)
+			if err != nil {
+				return err
+			}
+		}
+		d.SetPartial("auto_snapshot_policy_id")
+	}
+
 	d.Partial(false)
 
 	return nil
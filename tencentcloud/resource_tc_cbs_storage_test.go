@@ -3,6 +3,7 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -69,6 +70,47 @@ func TestAccTencentCloudCbsStorage_full(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudCbsStorage_prepaid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCbsStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsStorage_prepaid(1, "NOTIFY_AND_MANUAL_RENEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageExists("tencentcloud_cbs_storage.storage_prepaid"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_prepaid", "charge_type", "PREPAID"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_prepaid", "period", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_prepaid", "renew_flag", "NOTIFY_AND_MANUAL_RENEW"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_storage.storage_prepaid", "deadline_time"),
+				),
+			},
+			{
+				Config: testAccCbsStorage_prepaid(2, "NOTIFY_AND_AUTO_RENEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageExists("tencentcloud_cbs_storage.storage_prepaid"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_prepaid", "period", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_prepaid", "renew_flag", "NOTIFY_AND_AUTO_RENEW"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudCbsStorage_invalidSize(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCbsStorage_invalidSize,
+				ExpectError: regexp.MustCompile("must not be less than"),
+			},
+		},
+	})
+}
+
 func testAccCheckCbsStorageDestroy(s *terraform.State) error {
 	logId := GetLogId(nil)
 	ctx := context.WithValue(context.TODO(), "logId", logId)
@@ -147,3 +189,26 @@ resource "tencentcloud_cbs_storage" "storage_full" {
 	}
 }
 `
+
+func testAccCbsStorage_prepaid(period int, renewFlag string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cbs_storage" "storage_prepaid" {
+	storage_type      = "CLOUD_PREMIUM"
+	storage_name      = "tf-storage-prepaid"
+	storage_size      = 50
+	availability_zone = "ap-guangzhou-3"
+	charge_type       = "PREPAID"
+	period            = %d
+	renew_flag        = "%s"
+}
+`, period, renewFlag)
+}
+
+const testAccCbsStorage_invalidSize = `
+resource "tencentcloud_cbs_storage" "storage_invalid" {
+	storage_type      = "CLOUD_SSD"
+	storage_name      = "tf-storage-invalid"
+	storage_size      = 10
+	availability_zone = "ap-guangzhou-3"
+}
+`
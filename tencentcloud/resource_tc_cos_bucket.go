@@ -25,6 +25,37 @@ resource "tencentcloud_cos_bucket" "mycos" {
 }
 ```
 
+Using website redirection and hotlink protection
+
+```hcl
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket = "mycos-1258798060"
+  acl    = "public-read"
+
+  website {
+    index_document           = "index.html"
+    error_document           = "error.html"
+    redirect_all_requests_to = "https"
+
+    routing_rules {
+      condition_error_code = "404"
+      redirect_replace_key = "404.html"
+    }
+
+    routing_rules {
+      condition_prefix            = "docs/"
+      redirect_protocol           = "https"
+      redirect_replace_key_prefix = "documents/"
+    }
+  }
+
+  referer {
+    allow_domains       = ["*.example.com"]
+    allow_empty_referer = false
+  }
+}
+```
+
 Using CORS
 
 ```hcl
@@ -62,6 +93,82 @@ resource "tencentcloud_cos_bucket" "mycos" {
 }
 ```
 
+Using versioning and cross-region replication
+
+```hcl
+resource "tencentcloud_cos_bucket" "replica" {
+  bucket             = "mycos-replica-1258798060"
+  versioning_enabled = true
+}
+
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket             = "mycos-1258798060"
+  versioning_enabled = true
+
+  lifecycle_rules {
+    filter_prefix = "logs/"
+    non_current_transition {
+      non_current_days = 30
+      storage_class    = "STANDARD_IA"
+    }
+    non_current_expiration {
+      non_current_days = 180
+    }
+  }
+
+  replication {
+    role = "qcs::cam::uin/100000000001:uin/100000000001"
+
+    rules {
+      id                        = "audit-logs"
+      status                    = "Enabled"
+      prefix                    = "logs/"
+      destination_bucket        = "qcs::cos:ap-shanghai::${tencentcloud_cos_bucket.replica.bucket}"
+      destination_storage_class = "STANDARD_IA"
+    }
+  }
+}
+```
+
+Using tags, access logging and default encryption
+
+```hcl
+resource "tencentcloud_cos_bucket" "log" {
+  bucket = "mycos-log-1258798060"
+}
+
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket = "mycos-1258798060"
+
+  tags = {
+    "team" = "audit"
+  }
+
+  logging {
+    target_bucket = "${tencentcloud_cos_bucket.log.bucket}"
+    target_prefix = "access/"
+  }
+
+  encryption {
+    sse_algorithm = "AES256"
+  }
+}
+```
+
+Using object lock
+
+```hcl
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket             = "mycos-1258798060"
+  versioning_enabled = true
+
+  object_lock {
+    retention_mode = "COMPLIANCE"
+    retention_days = 180
+  }
+}
+```
+
 Import
 
 COS bucket can be imported, e.g.
@@ -80,6 +187,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -91,12 +199,54 @@ const (
 	tencentCloudCosStorageClassArchive    = "ARCHIVE"
 )
 
+const (
+	tencentCloudCosReplicationStatusEnabled  = "Enabled"
+	tencentCloudCosReplicationStatusDisabled = "Disabled"
+)
+
+const (
+	tencentCloudCosSSEAlgorithmAES256 = "AES256"
+	tencentCloudCosSSEAlgorithmKMS    = "KMS"
+)
+
+const (
+	tencentCloudCosRefererStatusEnabled  = "Enabled"
+	tencentCloudCosRefererStatusDisabled = "Disabled"
+
+	tencentCloudCosRefererTypeWhiteList = "White-List"
+	tencentCloudCosRefererTypeBlackList = "Black-List"
+
+	tencentCloudCosEmptyRefererAllow = "Allow"
+	tencentCloudCosEmptyRefererDeny  = "Deny"
+)
+
+const (
+	tencentCloudCosWebsiteProtocolHttp  = "http"
+	tencentCloudCosWebsiteProtocolHttps = "https"
+)
+
 var (
+	availableCosWebsiteProtocol = []string{
+		tencentCloudCosWebsiteProtocolHttp,
+		tencentCloudCosWebsiteProtocolHttps,
+	}
+	availableCosSSEAlgorithm = []string{
+		tencentCloudCosSSEAlgorithmAES256,
+		tencentCloudCosSSEAlgorithmKMS,
+	}
 	availableCosStorageClass = []string{
 		tencentCloudCosStorageClassStandard,
 		tencentCloudCosStorageClassStandardIA,
 		tencentCloudCosStorageClassArchive,
 	}
+	availableCosReplicationStatus = []string{
+		tencentCloudCosReplicationStatusEnabled,
+		tencentCloudCosReplicationStatusDisabled,
+	}
+	availableCosObjectLockRetentionMode = []string{
+		s3.ObjectLockRetentionModeGovernance,
+		s3.ObjectLockRetentionModeCompliance,
+	}
 )
 
 func resourceTencentCloudCosBucket() *schema.Resource {
@@ -228,6 +378,175 @@ func resourceTencentCloudCosBucket() *schema.Resource {
 								},
 							},
 						},
+						"non_current_transition": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Set:         nonCurrentTransitionHash,
+							Description: "Specifies when noncurrent object versions transition, it requires `versioning_enabled` (documented below).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"non_current_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateIntegerMin(0),
+										Description:  "Specifies the number of days after the object becomes noncurrent when the specific rule action takes effect.",
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue(availableCosStorageClass),
+										Description:  "Specifies the storage class to which you want the noncurrent object to transition. Available values include STANDARD, STANDARD_IA and ARCHIVE.",
+									},
+								},
+							},
+						},
+						"non_current_expiration": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Set:         nonCurrentExpirationHash,
+							MaxItems:    1,
+							Description: "Specifies when noncurrent object versions expire, it requires `versioning_enabled` (documented below).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"non_current_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateIntegerMin(1),
+										Description:  "Specifies the number of days after the object becomes noncurrent when the specific rule action takes effect.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to keep multiple versions of the objects in the bucket. Versioning can only be suspended once it is enabled. Default is false.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The tags of the bucket.",
+			},
+			"logging": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A configuration of access logging, the logs are delivered to another bucket in the same region (documented below).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_bucket": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The bucket to store the access logs.",
+						},
+						"target_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Object key prefix of the access logs.",
+						},
+					},
+				},
+			},
+			"encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A configuration of the default server-side encryption of objects (documented below).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(availableCosSSEAlgorithm),
+							Description:  "Server-side encryption algorithm. Available values include AES256 and KMS.",
+						},
+						"kms_master_key_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the KMS master key, it only works with the KMS algorithm and the default key is used if it is empty.",
+						},
+					},
+				},
+			},
+			"replication": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A cross-region replication configuration, it requires `versioning_enabled` of both the source and destination buckets (documented below).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Identity of the replication initiator, the format is `qcs::cam::uin/<owner uin>:uin/<sub account uin>`.",
+						},
+						"rules": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Replication rules of the bucket (documented below).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "Name of the rule.",
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      tencentCloudCosReplicationStatusEnabled,
+										ValidateFunc: validateAllowedStringValue(availableCosReplicationStatus),
+										Description:  "Status of the rule. Available values include Enabled and Disabled. Default is Enabled.",
+									},
+									"prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Object key prefix identifying the objects to replicate, all the objects are replicated if it is empty.",
+									},
+									"destination_bucket": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The destination bucket, the format is `qcs::cos:<region>::<bucket name>`.",
+									},
+									"destination_storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateAllowedStringValue(availableCosStorageClass),
+										Description:  "Storage class of the replicas. Available values include STANDARD, STANDARD_IA and ARCHIVE, and the storage class of the source object is kept by default.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_lock": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "An object lock configuration which protects the object versions from being deleted or overwritten in the retention period, it requires `versioning_enabled`. The object lock can't be disabled once it is enabled (documented below).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      s3.ObjectLockRetentionModeCompliance,
+							ValidateFunc: validateAllowedStringValue(availableCosObjectLockRetentionMode),
+							Description:  "Default retention mode of the new object versions. Available values include GOVERNANCE and COMPLIANCE. Default is COMPLIANCE.",
+						},
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(1, 36500),
+							Description:  "Default retention period in days of the new object versions, the value range is [1, 36500].",
+						},
 					},
 				},
 			},
@@ -248,6 +567,77 @@ func resourceTencentCloudCosBucket() *schema.Resource {
 							Optional:    true,
 							Description: "An absolute path to the document to return in case of a 4XX error.",
 						},
+						"redirect_all_requests_to": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAllowedStringValue([]string{tencentCloudCosWebsiteProtocolHttps}),
+							Description:  "Redirects all the requests to the website with the protocol. Only https is available now.",
+						},
+						"routing_rules": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Routing rules of the website, they are matched in order (documented below).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition_error_code": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP error code to match, only 4XX codes are supported. Conflicts with `condition_prefix`.",
+									},
+									"condition_prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The object key prefix to match. Conflicts with `condition_error_code`.",
+									},
+									"redirect_protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAllowedStringValue(availableCosWebsiteProtocol),
+										Description:  "The protocol of the redirected request. Available values include http and https.",
+									},
+									"redirect_replace_key": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The object key which replaces the whole key of the request. Conflicts with `redirect_replace_key_prefix`.",
+									},
+									"redirect_replace_key_prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The object key prefix which replaces the `condition_prefix` of the request, it only works with `condition_prefix`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"referer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A hotlink protection configuration based on the Referer header of the requests (documented below).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_domains": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"referer.0.deny_domains"},
+							Description:   "Domains allowed to access the bucket, the requests from other domains are denied. Wildcards such as `*.example.com` are supported. Conflicts with `deny_domains`.",
+						},
+						"deny_domains": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"referer.0.allow_domains"},
+							Description:   "Domains denied to access the bucket. Wildcards such as `*.example.com` are supported. Conflicts with `allow_domains`.",
+						},
+						"allow_empty_referer": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Indicates whether the requests without the Referer header are allowed. Default is true.",
+						},
 					},
 				},
 			},
@@ -315,6 +705,67 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("setting website error: %s", err.Error())
 	}
 
+	// read the referer
+	referer, err := cosService.GetBucketReferer(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("referer", referer); err != nil {
+		return fmt.Errorf("setting referer error: %s", err.Error())
+	}
+
+	// read the versioning
+	versioningEnabled, err := cosService.GetBucketVersioning(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	d.Set("versioning_enabled", versioningEnabled)
+
+	// read the tags
+	tags, err := cosService.GetBucketTags(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("tags", tags); err != nil {
+		return fmt.Errorf("setting tags error: %s", err.Error())
+	}
+
+	// read the logging
+	logging, err := cosService.GetBucketLogging(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("logging", logging); err != nil {
+		return fmt.Errorf("setting logging error: %s", err.Error())
+	}
+
+	// read the encryption
+	encryption, err := cosService.GetBucketEncryption(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("encryption", encryption); err != nil {
+		return fmt.Errorf("setting encryption error: %s", err.Error())
+	}
+
+	// read the replication
+	replication, err := cosService.GetBucketReplication(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("replication", replication); err != nil {
+		return fmt.Errorf("setting replication error: %s", err.Error())
+	}
+
+	// read the object lock
+	objectLock, err := cosService.GetBucketObjectLock(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("object_lock", objectLock); err != nil {
+		return fmt.Errorf("setting object_lock error: %s", err.Error())
+	}
+
 	return nil
 }
 
@@ -324,8 +775,39 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 
 	client := meta.(*TencentCloudClient).apiV3Conn.UseCosClient()
 
+	if d.HasChange("object_lock") && len(d.Get("object_lock").([]interface{})) == 0 {
+		return fmt.Errorf("object lock of cos bucket %s can not be disabled once it is enabled", d.Id())
+	}
+
 	d.Partial(true)
 
+	// the replication must be removed before the versioning is suspended
+	replicationRemoved := d.HasChange("replication") && len(d.Get("replication").([]interface{})) == 0
+	if replicationRemoved {
+		err := resourceTencentCloudCosBucketReplicationUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("replication")
+	}
+
+	if d.HasChange("versioning_enabled") {
+		err := resourceTencentCloudCosBucketVersioningUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("versioning_enabled")
+	}
+
+	// the object lock requires the versioning
+	if d.HasChange("object_lock") {
+		err := resourceTencentCloudCosBucketObjectLockUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("object_lock")
+	}
+
 	if d.HasChange("acl") {
 		err := resourceTencentCloudCosBucketAclUpdate(ctx, client, d)
 		if err != nil {
@@ -358,6 +840,46 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		d.SetPartial("website")
 	}
 
+	if d.HasChange("referer") {
+		err := resourceTencentCloudCosBucketRefererUpdate(ctx, meta, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("referer")
+	}
+
+	if d.HasChange("tags") {
+		err := resourceTencentCloudCosBucketTagsUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+	}
+
+	if d.HasChange("logging") {
+		err := resourceTencentCloudCosBucketLoggingUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("logging")
+	}
+
+	if d.HasChange("encryption") {
+		err := resourceTencentCloudCosBucketEncryptionUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("encryption")
+	}
+
+	if d.HasChange("replication") && !replicationRemoved {
+		err := resourceTencentCloudCosBucketReplicationUpdate(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("replication")
+	}
+
 	d.Partial(false)
 
 	// wait for update cache
@@ -543,6 +1065,28 @@ func resourceTencentCloudCosBucketLifecycleUpdate(ctx context.Context, client *s
 
 				rule.Expiration = e
 			}
+
+			// NoncurrentVersionTransitions
+			nonCurrentTransitions := d.Get(fmt.Sprintf("lifecycle_rules.%d.non_current_transition", i)).(*schema.Set).List()
+			if len(nonCurrentTransitions) > 0 {
+				rule.NoncurrentVersionTransitions = make([]*s3.NoncurrentVersionTransition, 0, len(nonCurrentTransitions))
+				for _, transition := range nonCurrentTransitions {
+					transitionValue := transition.(map[string]interface{})
+					rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, &s3.NoncurrentVersionTransition{
+						NoncurrentDays: aws.Int64(int64(transitionValue["non_current_days"].(int))),
+						StorageClass:   aws.String(transitionValue["storage_class"].(string)),
+					})
+				}
+			}
+
+			// NoncurrentVersionExpiration
+			nonCurrentExpirations := d.Get(fmt.Sprintf("lifecycle_rules.%d.non_current_expiration", i)).(*schema.Set).List()
+			if len(nonCurrentExpirations) > 0 {
+				expiration := nonCurrentExpirations[0].(map[string]interface{})
+				rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+					NoncurrentDays: aws.Int64(int64(expiration["non_current_days"].(int))),
+				}
+			}
 			rules = append(rules, rule)
 		}
 
@@ -608,7 +1152,54 @@ func resourceTencentCloudCosBucketWebsiteUpdate(ctx context.Context, client *s3.
 				},
 			},
 		}
-		response, err := client.PutBucketWebsite(&request)
+		if v, ok := w["redirect_all_requests_to"]; ok && v.(string) != "" {
+			request.WebsiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+				Protocol: aws.String(v.(string)),
+			}
+		}
+		if v, ok := w["routing_rules"]; ok {
+			for _, item := range v.([]interface{}) {
+				var r map[string]interface{}
+				if item != nil {
+					r = item.(map[string]interface{})
+				} else {
+					r = make(map[string]interface{})
+				}
+				rule := &s3.RoutingRule{
+					Redirect: &s3.Redirect{},
+				}
+				condition := &s3.Condition{}
+				if val := r["condition_error_code"].(string); val != "" {
+					condition.HttpErrorCodeReturnedEquals = aws.String(val)
+				}
+				if val := r["condition_prefix"].(string); val != "" {
+					condition.KeyPrefixEquals = aws.String(val)
+				}
+				if condition.HttpErrorCodeReturnedEquals != nil && condition.KeyPrefixEquals != nil {
+					return fmt.Errorf("condition_error_code and condition_prefix of the website routing rule can not be set at the same time")
+				}
+				if condition.HttpErrorCodeReturnedEquals != nil || condition.KeyPrefixEquals != nil {
+					rule.Condition = condition
+				}
+				if val := r["redirect_protocol"].(string); val != "" {
+					rule.Redirect.Protocol = aws.String(val)
+				}
+				if val := r["redirect_replace_key"].(string); val != "" {
+					rule.Redirect.ReplaceKeyWith = aws.String(val)
+				}
+				if val := r["redirect_replace_key_prefix"].(string); val != "" {
+					rule.Redirect.ReplaceKeyPrefixWith = aws.String(val)
+				}
+				if rule.Redirect.ReplaceKeyWith != nil && rule.Redirect.ReplaceKeyPrefixWith != nil {
+					return fmt.Errorf("redirect_replace_key and redirect_replace_key_prefix of the website routing rule can not be set at the same time")
+				}
+				request.WebsiteConfiguration.RoutingRules = append(request.WebsiteConfiguration.RoutingRules, rule)
+			}
+		}
+		// COS redirects to the website itself, so the host name required by the SDK validation is not needed
+		req, response := client.PutBucketWebsiteRequest(&request)
+		req.Handlers.Validate.RemoveByName(corehandlers.ValidateParametersHandler.Name)
+		err := req.Send()
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "put bucket website", request.String(), err.Error())
@@ -621,6 +1212,293 @@ func resourceTencentCloudCosBucketWebsiteUpdate(ctx context.Context, client *s3.
 	return nil
 }
 
+func resourceTencentCloudCosBucketRefererUpdate(ctx context.Context, meta interface{}, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	referer := d.Get("referer").([]interface{})
+
+	configuration := cosRefererConfiguration{
+		Status: aws.String(tencentCloudCosRefererStatusDisabled),
+	}
+	if len(referer) > 0 {
+		var r map[string]interface{}
+		if referer[0] != nil {
+			r = referer[0].(map[string]interface{})
+		} else {
+			r = make(map[string]interface{})
+		}
+		allowDomains := r["allow_domains"].([]interface{})
+		denyDomains := r["deny_domains"].([]interface{})
+		if len(allowDomains) == 0 && len(denyDomains) == 0 {
+			return fmt.Errorf("one of allow_domains and deny_domains of the referer must be set")
+		}
+
+		configuration.Status = aws.String(tencentCloudCosRefererStatusEnabled)
+		if len(denyDomains) > 0 {
+			configuration.RefererType = aws.String(tencentCloudCosRefererTypeBlackList)
+			configuration.DomainList = aws.StringSlice(expandStringList(denyDomains))
+		} else {
+			configuration.RefererType = aws.String(tencentCloudCosRefererTypeWhiteList)
+			configuration.DomainList = aws.StringSlice(expandStringList(allowDomains))
+		}
+		if r["allow_empty_referer"].(bool) {
+			configuration.EmptyReferConfiguration = aws.String(tencentCloudCosEmptyRefererAllow)
+		} else {
+			configuration.EmptyReferConfiguration = aws.String(tencentCloudCosEmptyRefererDeny)
+		}
+	}
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	return cosService.PutBucketReferer(ctx, bucket, &configuration)
+}
+
+func resourceTencentCloudCosBucketVersioningUpdate(ctx context.Context, client *s3.S3, d *schema.ResourceData) error {
+	logId := GetLogId(ctx)
+
+	bucket := d.Get("bucket").(string)
+	status := s3.BucketVersioningStatusSuspended
+	if d.Get("versioning_enabled").(bool) {
+		status = s3.BucketVersioningStatusEnabled
+	}
+	request := s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(status),
+		},
+	}
+	response, err := client.PutBucketVersioning(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket versioning", request.String(), err.Error())
+		return fmt.Errorf("cos put bucket versioning error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put bucket versioning", request.String(), response.String())
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketReplicationUpdate(ctx context.Context, client *s3.S3, d *schema.ResourceData) error {
+	logId := GetLogId(ctx)
+
+	bucket := d.Get("bucket").(string)
+	replication := d.Get("replication").([]interface{})
+
+	if len(replication) == 0 || replication[0] == nil {
+		request := s3.DeleteBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		}
+		response, err := client.DeleteBucketReplication(&request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "delete bucket replication", request.String(), err.Error())
+			return fmt.Errorf("cos delete bucket replication error: %s, bucket: %s", err.Error(), bucket)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, "delete bucket replication", request.String(), response.String())
+		return nil
+	}
+
+	if !d.Get("versioning_enabled").(bool) {
+		return fmt.Errorf("cos bucket replication requires versioning_enabled, bucket: %s", bucket)
+	}
+	r := replication[0].(map[string]interface{})
+	replicationRules := r["rules"].([]interface{})
+	rules := make([]*s3.ReplicationRule, 0, len(replicationRules))
+	for _, item := range replicationRules {
+		m := item.(map[string]interface{})
+		rule := &s3.ReplicationRule{
+			Status: aws.String(m["status"].(string)),
+			Prefix: aws.String(m["prefix"].(string)),
+			Destination: &s3.Destination{
+				Bucket: aws.String(m["destination_bucket"].(string)),
+			},
+		}
+		if v := m["id"].(string); v != "" {
+			rule.ID = aws.String(v)
+		}
+		if v := m["destination_storage_class"].(string); v != "" {
+			rule.Destination.StorageClass = aws.String(v)
+		}
+		rules = append(rules, rule)
+	}
+	request := s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(r["role"].(string)),
+			Rules: rules,
+		},
+	}
+	response, err := client.PutBucketReplication(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket replication", request.String(), err.Error())
+		return fmt.Errorf("cos put bucket replication error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put bucket replication", request.String(), response.String())
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketObjectLockUpdate(ctx context.Context, client *s3.S3, d *schema.ResourceData) error {
+	logId := GetLogId(ctx)
+
+	bucket := d.Get("bucket").(string)
+	if !d.Get("versioning_enabled").(bool) {
+		return fmt.Errorf("cos bucket object lock requires versioning_enabled, bucket: %s", bucket)
+	}
+	objectLock := d.Get("object_lock").([]interface{})[0].(map[string]interface{})
+	request := s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled),
+			Rule: &s3.ObjectLockRule{
+				DefaultRetention: &s3.DefaultRetention{
+					Mode: aws.String(objectLock["retention_mode"].(string)),
+					Days: aws.Int64(int64(objectLock["retention_days"].(int))),
+				},
+			},
+		},
+	}
+	response, err := client.PutObjectLockConfiguration(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put object lock configuration", request.String(), err.Error())
+		return fmt.Errorf("cos put object lock configuration error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put object lock configuration", request.String(), response.String())
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketTagsUpdate(ctx context.Context, client *s3.S3, d *schema.ResourceData) error {
+	logId := GetLogId(ctx)
+
+	bucket := d.Get("bucket").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if len(tags) == 0 {
+		request := s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(bucket),
+		}
+		response, err := client.DeleteBucketTagging(&request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "delete bucket tagging", request.String(), err.Error())
+			return fmt.Errorf("cos delete bucket tagging error: %s, bucket: %s", err.Error(), bucket)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, "delete bucket tagging", request.String(), response.String())
+		return nil
+	}
+
+	tagSet := make([]*s3.Tag, 0, len(tags))
+	for k, v := range tags {
+		tagSet = append(tagSet, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+	request := s3.PutBucketTaggingInput{
+		Bucket: aws.String(bucket),
+		Tagging: &s3.Tagging{
+			TagSet: tagSet,
+		},
+	}
+	response, err := client.PutBucketTagging(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket tagging", request.String(), err.Error())
+		return fmt.Errorf("cos put bucket tagging error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put bucket tagging", request.String(), response.String())
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketLoggingUpdate(ctx context.Context, client *s3.S3, d *schema.ResourceData) error {
+	logId := GetLogId(ctx)
+
+	bucket := d.Get("bucket").(string)
+	logging := d.Get("logging").([]interface{})
+
+	// an empty logging status disables the access logging
+	status := &s3.BucketLoggingStatus{}
+	if len(logging) > 0 && logging[0] != nil {
+		l := logging[0].(map[string]interface{})
+		status.LoggingEnabled = &s3.LoggingEnabled{
+			TargetBucket: aws.String(l["target_bucket"].(string)),
+			TargetPrefix: aws.String(l["target_prefix"].(string)),
+		}
+	}
+	request := s3.PutBucketLoggingInput{
+		Bucket:              aws.String(bucket),
+		BucketLoggingStatus: status,
+	}
+	response, err := client.PutBucketLogging(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket logging", request.String(), err.Error())
+		return fmt.Errorf("cos put bucket logging error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put bucket logging", request.String(), response.String())
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketEncryptionUpdate(ctx context.Context, client *s3.S3, d *schema.ResourceData) error {
+	logId := GetLogId(ctx)
+
+	bucket := d.Get("bucket").(string)
+	encryption := d.Get("encryption").([]interface{})
+
+	if len(encryption) == 0 || encryption[0] == nil {
+		request := s3.DeleteBucketEncryptionInput{
+			Bucket: aws.String(bucket),
+		}
+		response, err := client.DeleteBucketEncryption(&request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "delete bucket encryption", request.String(), err.Error())
+			return fmt.Errorf("cos delete bucket encryption error: %s, bucket: %s", err.Error(), bucket)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, "delete bucket encryption", request.String(), response.String())
+		return nil
+	}
+
+	e := encryption[0].(map[string]interface{})
+	byDefault := &s3.ServerSideEncryptionByDefault{
+		SSEAlgorithm: aws.String(e["sse_algorithm"].(string)),
+	}
+	if v := e["kms_master_key_id"].(string); v != "" {
+		byDefault.KMSMasterKeyID = aws.String(v)
+	}
+	request := s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{ApplyServerSideEncryptionByDefault: byDefault},
+			},
+		},
+	}
+	response, err := client.PutBucketEncryption(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket encryption", request.String(), err.Error())
+		return fmt.Errorf("cos put bucket encryption error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put bucket encryption", request.String(), response.String())
+
+	return nil
+}
+
 func expirationHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -647,3 +1525,24 @@ func transitionHash(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
+
+func nonCurrentExpirationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["non_current_days"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func nonCurrentTransitionHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["non_current_days"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	if v, ok := m["storage_class"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,271 @@
+/*
+Provides a COS resource to bind a custom domain to a bucket.
+
+~> **NOTE:** The domain must be resolved to the default domain of the bucket with a CNAME record, otherwise `forced_replacement` is required to replace the existing record.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket = "mycos-1258798060"
+}
+
+resource "tencentcloud_cos_bucket_domain" "domain" {
+  bucket      = "${tencentcloud_cos_bucket.mycos.bucket}"
+  domain_name = "static.example.com"
+  type        = "REST"
+}
+```
+
+Import
+
+COS bucket domain can be imported using the bucket and the domain name, e.g.
+
+```
+$ terraform import tencentcloud_cos_bucket_domain.domain mycos-1258798060#static.example.com
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	tencentCloudCosDomainStatusEnabled  = "ENABLED"
+	tencentCloudCosDomainStatusDisabled = "DISABLED"
+)
+
+const (
+	tencentCloudCosDomainTypeRest    = "REST"
+	tencentCloudCosDomainTypeWebsite = "WEBSITE"
+)
+
+const (
+	tencentCloudCosDomainForcedReplacementCname = "CNAME"
+	tencentCloudCosDomainForcedReplacementTxt   = "TXT"
+)
+
+var (
+	availableCosDomainStatus = []string{
+		tencentCloudCosDomainStatusEnabled,
+		tencentCloudCosDomainStatusDisabled,
+	}
+	availableCosDomainType = []string{
+		tencentCloudCosDomainTypeRest,
+		tencentCloudCosDomainTypeWebsite,
+	}
+	availableCosDomainForcedReplacement = []string{
+		tencentCloudCosDomainForcedReplacementCname,
+		tencentCloudCosDomainForcedReplacementTxt,
+	}
+)
+
+// all the domains of a bucket are put at once, so the modifications must be serialized
+var cosDomainActionMu = &sync.Mutex{}
+
+func resourceTencentCloudCosBucketDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCosBucketDomainCreate,
+		Read:   resourceTencentCloudCosBucketDomainRead,
+		Update: resourceTencentCloudCosBucketDomainUpdate,
+		Delete: resourceTencentCloudCosBucketDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCosBucketName,
+				Description:  "The name of the bucket.",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The custom domain name.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tencentCloudCosDomainTypeRest,
+				ValidateFunc: validateAllowedStringValue(availableCosDomainType),
+				Description:  "Type of the origin the domain serves. Available values include REST and WEBSITE, and WEBSITE requires the `website` of the bucket. Default is REST.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tencentCloudCosDomainStatusEnabled,
+				ValidateFunc: validateAllowedStringValue(availableCosDomainStatus),
+				Description:  "Status of the domain. Available values include ENABLED and DISABLED. Default is ENABLED.",
+			},
+			"forced_replacement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(availableCosDomainForcedReplacement),
+				Description:  "Replaces the existing DNS record of the domain. Available values include CNAME and TXT.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCosBucketDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	domainName := d.Get("domain_name").(string)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	cosDomainActionMu.Lock()
+	defer cosDomainActionMu.Unlock()
+
+	domains, err := cosService.GetBucketDomains(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains {
+		if aws.StringValue(domain.Name) == domainName {
+			return fmt.Errorf("cos bucket domain %s already exists, bucket: %s", domainName, bucket)
+		}
+	}
+	domains = append(domains, resourceTencentCloudCosBucketDomainRule(d))
+	err = cosService.PutBucketDomains(ctx, bucket, domains)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(bucket + FILED_SP + domainName)
+	return resourceTencentCloudCosBucketDomainRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketDomainRead(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	items := strings.Split(d.Id(), FILED_SP)
+	if len(items) != 2 {
+		return fmt.Errorf("id of the cos bucket domain is broken, id is %s", d.Id())
+	}
+	bucket, domainName := items[0], items[1]
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	domains, err := cosService.GetBucketDomains(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	var rule *cosDomainRule
+	for _, domain := range domains {
+		if aws.StringValue(domain.Name) == domainName {
+			rule = domain
+			break
+		}
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("domain_name", domainName)
+	d.Set("type", aws.StringValue(rule.Type))
+	d.Set("status", aws.StringValue(rule.Status))
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	domainName := d.Get("domain_name").(string)
+
+	if d.HasChange("type") || d.HasChange("status") || d.HasChange("forced_replacement") {
+		cosService := CosService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+
+		cosDomainActionMu.Lock()
+		defer cosDomainActionMu.Unlock()
+
+		domains, err := cosService.GetBucketDomains(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		found := false
+		for i, domain := range domains {
+			if aws.StringValue(domain.Name) == domainName {
+				domains[i] = resourceTencentCloudCosBucketDomainRule(d)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("cos bucket domain %s is not found, bucket: %s", domainName, bucket)
+		}
+		err = cosService.PutBucketDomains(ctx, bucket, domains)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudCosBucketDomainRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	domainName := d.Get("domain_name").(string)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	cosDomainActionMu.Lock()
+	defer cosDomainActionMu.Unlock()
+
+	domains, err := cosService.GetBucketDomains(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	remains := make([]*cosDomainRule, 0, len(domains))
+	for _, domain := range domains {
+		if aws.StringValue(domain.Name) != domainName {
+			remains = append(remains, domain)
+		}
+	}
+	if len(remains) == len(domains) {
+		return nil
+	}
+
+	return cosService.PutBucketDomains(ctx, bucket, remains)
+}
+
+func resourceTencentCloudCosBucketDomainRule(d *schema.ResourceData) *cosDomainRule {
+	rule := &cosDomainRule{
+		Name:   aws.String(d.Get("domain_name").(string)),
+		Type:   aws.String(d.Get("type").(string)),
+		Status: aws.String(d.Get("status").(string)),
+	}
+	if v, ok := d.GetOk("forced_replacement"); ok {
+		rule.ForcedReplacement = aws.String(v.(string))
+	}
+	return rule
+}
@@ -0,0 +1,130 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudCosBucketDomain(t *testing.T) {
+	domainName := os.Getenv("TENCENTCLOUD_COS_DOMAIN")
+	if domainName == "" {
+		t.Skip("TENCENTCLOUD_COS_DOMAIN must be set to a domain resolved to the bucket")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosBucketDomain(appid, domainName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketDomainExists("tencentcloud_cos_bucket_domain.domain"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_domain.domain", "domain_name", domainName),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_domain.domain", "type", "REST"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_domain.domain", "status", "ENABLED"),
+				),
+			},
+			// test update bucket domain
+			{
+				Config: testAccCosBucketDomain(appid, domainName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketDomainExists("tencentcloud_cos_bucket_domain.domain"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_domain.domain", "status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_cos_bucket_domain.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCosBucketDomainRule(ctx context.Context, id string) (*cosDomainRule, error) {
+	items := strings.Split(id, FILED_SP)
+	if len(items) != 2 {
+		return nil, fmt.Errorf("id of the cos bucket domain is broken, id is %s", id)
+	}
+	cosService := CosService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+	domains, err := cosService.GetBucketDomains(ctx, items[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, domain := range domains {
+		if aws.StringValue(domain.Name) == items[1] {
+			return domain, nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccCheckCosBucketDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("cos bucket domain %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("cos bucket domain id is not set")
+		}
+		domain, err := testAccCosBucketDomainRule(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if domain == nil {
+			return fmt.Errorf("cos bucket domain %s is not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCosBucketDomainDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_cos_bucket_domain" {
+			continue
+		}
+		domain, err := testAccCosBucketDomainRule(ctx, rs.Primary.ID)
+		if err != nil {
+			// the bucket has been deleted with the domains
+			if strings.Contains(err.Error(), "NoSuchBucket") {
+				continue
+			}
+			return err
+		}
+		if domain != nil {
+			return fmt.Errorf("cos bucket domain still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCosBucketDomain(appid, domainName, status string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_domain" {
+	bucket = "tf-bucket-domain-%s"
+}
+
+resource "tencentcloud_cos_bucket_domain" "domain" {
+	bucket = "${tencentcloud_cos_bucket.bucket_domain.bucket}"
+	domain_name = "%s"
+	status = "%s"
+}
+`, appid, domainName, status)
+}
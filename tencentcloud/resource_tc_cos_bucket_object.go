@@ -27,6 +27,27 @@ resource "tencentcloud_cos_bucket_object" "myobject" {
   content = "the content that you want to upload."
 }
 ```
+
+Uploading a large file in parallel parts
+
+```hcl
+resource "tencentcloud_cos_bucket_object" "myobject" {
+  bucket             = "mycos-1258798060"
+  key                = "new_object_key"
+  source             = "path/to/large/file"
+  source_hash        = "${filemd5("path/to/large/file")}"
+  part_size          = 16
+  upload_concurrency = 8
+}
+```
+
+Import
+
+COS bucket object can be imported with the bucket name and the object key joined by `#`, e.g.
+
+```
+$ terraform import tencentcloud_cos_bucket_object.myobject mycos-1258798060#new_object_key
+```
 */
 package tencentcloud
 
@@ -46,12 +67,25 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const (
+	tencentCloudCosObjectPartSizeDefault          = 8
+	tencentCloudCosObjectUploadConcurrencyDefault = 5
+	tencentCloudCosUploadPartRetries              = 3
+	tencentCloudCosMaxPartCount                   = 10000
+	tencentCloudCosMaxPartSize                    = 5 * 1024 * 1024 * 1024
+	tencentCloudCosPutObjectMaxSize               = 5 * 1024 * 1024 * 1024
+)
+
 func resourceTencentCloudCosBucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCosBucketObjectCreate,
 		Read:   resourceTencentCloudCosBucketObjectRead,
 		Update: resourceTencentCloudCosBucketObjectUpdate,
 		Delete: resourceTencentCloudCosBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCosBucketObjectImport,
+		},
+		CustomizeDiff: resourceTencentCloudCosBucketObjectCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -118,10 +152,38 @@ func resourceTencentCloudCosBucketObject() *schema.Resource {
 				Description:  "Object storage type, Available values include STANDARD, STANDARD_IA and ARCHIVE.",
 			},
 			"etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				// the ETag of an object uploaded in parts is not an MD5 sum of its content and can't be
+				// compared with the configured one, `source_hash` detects the changes of it instead
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.Contains(old, "-") && d.Get("source_hash").(string) != ""
+				},
+				Description: "The ETag generated for the object (an MD5 sum of the object content). It is not an MD5 sum for objects uploaded in parts, set `source_hash` to detect the changes of them instead, and the configured value is ignored for them then.",
+			},
+			"version_id": {
 				Type:        schema.TypeString,
-				Optional:    true,
 				Computed:    true,
-				Description: "The ETag generated for the object (an MD5 sum of the object content).",
+				Description: "Version ID of the object, it is set when the versioning of the bucket is enabled.",
+			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary hash of the source content, such as `filemd5(\"path/to/file\")`, the object is uploaded again when it changes. It works independently of the ETag format of COS.",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(1, 5120),
+				Description:  "Size in MB of each part when uploading `source` in parts, and it is raised automatically when a file needs more than 10000 parts. Files larger than it are uploaded in parts when it or `source_hash` is set, it is 8 when only `source_hash` is set. Otherwise only files larger than 5 GB are uploaded in parts.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      tencentCloudCosObjectUploadConcurrencyDefault,
+				ValidateFunc: validateIntegerInRange(1, 32),
+				Description:  "Number of parts uploaded in parallel. Default is 5.",
 			},
 		},
 	}
@@ -133,13 +195,15 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	var body io.ReadSeeker
+	var file *os.File
+	var size int64
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
 		path, err := homedir.Expand(source)
 		if err != nil {
 			return fmt.Errorf("cos object source (%s) homedir expand error: %s", source, err.Error())
 		}
-		file, err := os.Open(path)
+		file, err = os.Open(path)
 		if err != nil {
 			return fmt.Errorf("cos object source (%s) open error: %s", source, err.Error())
 		}
@@ -150,6 +214,11 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 				log.Printf("closing cos object source (%s) error: %s", path, err.Error())
 			}
 		}()
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("cos object source (%s) stat error: %s", source, err.Error())
+		}
+		size = info.Size()
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
@@ -157,6 +226,54 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("must specify \"source\" or \"content\" field")
 	}
 
+	// the objects configured before uploading in parts keep being put in a whole, so that their ETag
+	// stays an MD5 sum, unless the part size is set or they are too large to put in a whole
+	partSize := int64(tencentCloudCosObjectPartSizeDefault) * 1024 * 1024
+	multipart := size > tencentCloudCosPutObjectMaxSize
+	if v, ok := d.GetOk("part_size"); ok {
+		partSize = int64(v.(int)) * 1024 * 1024
+		multipart = size > partSize
+	} else if _, ok := d.GetOk("source_hash"); ok {
+		multipart = size > partSize
+	}
+	if file != nil && multipart {
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		request := &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if v, ok := d.GetOk("acl"); ok {
+			request.ACL = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("cache_control"); ok {
+			request.CacheControl = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_disposition"); ok {
+			request.ContentDisposition = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_encoding"); ok {
+			request.ContentEncoding = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("content_type"); ok {
+			request.ContentType = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("storage_class"); ok {
+			request.StorageClass = aws.String(v.(string))
+		}
+
+		cosService := CosService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		_, err := cosService.PutObjectMultipart(ctx, request, file, size, partSize, d.Get("upload_concurrency").(int))
+		if err != nil {
+			return fmt.Errorf("puting object (%s) in cos bucket (%s) error: %s", key, bucket, err.Error())
+		}
+
+		d.SetId(bucket + key)
+		return resourceTencentCloudCosBucketObjectRead(d, meta)
+	}
+
 	request := &s3.PutObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
@@ -193,6 +310,19 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 	return resourceTencentCloudCosBucketObjectRead(d, meta)
 }
 
+func resourceTencentCloudCosBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	items := strings.SplitN(d.Id(), FILED_SP, 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return nil, fmt.Errorf("cos object id (%s) is invalid, the format is <bucket>%s<key>", d.Id(), FILED_SP)
+	}
+	d.Set("bucket", items[0])
+	d.Set("key", items[1])
+	d.Set("upload_concurrency", tencentCloudCosObjectUploadConcurrencyDefault)
+	d.SetId(items[0] + items[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
 	ctx := context.WithValue(context.TODO(), "logId", logId)
@@ -213,6 +343,7 @@ func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interf
 	d.Set("content_encoding", response.ContentEncoding)
 	d.Set("content_type", response.ContentType)
 	d.Set("etag", strings.Trim(*response.ETag, `"`))
+	d.Set("version_id", response.VersionId)
 	d.Set("storage_class", s3.StorageClassStandard)
 	if response.StorageClass != nil {
 		d.Set("storage_class", response.StorageClass)
@@ -221,6 +352,19 @@ func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceTencentCloudCosBucketObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("etag") {
+		return nil
+	}
+	// the object is uploaded again, so the ETag is only known after that
+	for _, key := range []string{"source", "content", "source_hash"} {
+		if d.HasChange(key) {
+			return d.SetNewComputed("etag")
+		}
+	}
+	return nil
+}
+
 func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(nil)
 	ctx := context.WithValue(context.TODO(), "logId", logId)
@@ -234,6 +378,7 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 		"content",
 		"storage_class",
 		"etag",
+		"source_hash",
 	}
 	for _, key := range fields {
 		if d.HasChange(key) {
@@ -0,0 +1,355 @@
+/*
+Provides a COS object resource to copy an object from another bucket or object key on the server side.
+
+~> **NOTE:** The source object must not be larger than 5GB. The object is copied again if the copy is deleted or overwritten outside of Terraform.
+
+Example Usage
+
+Copying an object to a bucket in another region
+
+```hcl
+resource "tencentcloud_cos_bucket_object" "artifact" {
+  bucket = "mycos-1258798060"
+  key    = "builds/app.tar.gz"
+  source = "path/to/app.tar.gz"
+}
+
+resource "tencentcloud_cos_bucket_object_copy" "release" {
+  bucket        = "mycos-release-1258798060"
+  key           = "releases/app.tar.gz"
+  source_bucket = "${tencentcloud_cos_bucket_object.artifact.bucket}"
+  source_key    = "${tencentcloud_cos_bucket_object.artifact.key}"
+  source_region = "ap-guangzhou"
+  source_etag   = "${tencentcloud_cos_bucket_object.artifact.etag}"
+}
+```
+
+Replacing the metadata of the copy
+
+```hcl
+resource "tencentcloud_cos_bucket_object_copy" "release" {
+  bucket             = "mycos-release-1258798060"
+  key                = "releases/index.html"
+  source_bucket      = "mycos-1258798060"
+  source_key         = "builds/index.html"
+  metadata_directive = "Replaced"
+  content_type       = "text/html; charset=utf-8"
+  cache_control      = "max-age=300"
+  storage_class      = "STANDARD_IA"
+
+  metadata = {
+    "build" = "1024"
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	tencentCloudCosMetadataDirectiveCopy     = "Copy"
+	tencentCloudCosMetadataDirectiveReplaced = "Replaced"
+)
+
+var availableCosMetadataDirective = []string{
+	tencentCloudCosMetadataDirectiveCopy,
+	tencentCloudCosMetadataDirectiveReplaced,
+}
+
+func resourceTencentCloudCosBucketObjectCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCosBucketObjectCopyCreate,
+		Read:   resourceTencentCloudCosBucketObjectCopyRead,
+		Update: resourceTencentCloudCosBucketObjectCopyUpdate,
+		Delete: resourceTencentCloudCosBucketObjectCopyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the destination bucket.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the destination object.",
+			},
+			"source_bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket of the source object.",
+			},
+			"source_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the source object.",
+			},
+			"source_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region of the source bucket, the region of the provider is used by default.",
+			},
+			"source_version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version ID of the source object, the latest version is copied by default.",
+			},
+			"source_etag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ETag of the source object, such as the `etag` of a `tencentcloud_cos_bucket_object`, the object is copied again when it changes.",
+			},
+			"metadata_directive": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tencentCloudCosMetadataDirectiveCopy,
+				ValidateFunc: validateAllowedStringValue(availableCosMetadataDirective),
+				Description:  "Specifies whether the metadata is copied from the source object or replaced with the metadata arguments. Available values include Copy and Replaced. Default is Copy.",
+			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies caching behavior along the request/reply chain, it only works with the Replaced `metadata_directive`.",
+			},
+			"content_disposition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies presentational information for the object, it only works with the Replaced `metadata_directive`.",
+			},
+			"content_encoding": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies what content encodings have been applied to the object, it only works with the Replaced `metadata_directive`.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A standard MIME type describing the format of the object data, it only works with the Replaced `metadata_directive`.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "User defined metadata of the object, it only works with the Replaced `metadata_directive`.",
+			},
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.ObjectCannedACLPrivate,
+				ValidateFunc: validateAllowedStringValue([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+				}),
+				Description: "The canned ACL to apply. Available values include private, public-read, and public-read-write. Defaults to private.",
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(availableCosStorageClass),
+				Description:  "Storage class of the copy. Available values include STANDARD, STANDARD_IA and ARCHIVE, and the storage class of the source object is kept by default.",
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ETag of the copy.",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version ID of the copy, it is set when the versioning of the destination bucket is enabled.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCosBucketObjectCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	sourceRegion := meta.(*TencentCloudClient).apiV3Conn.Region
+	if v, ok := d.GetOk("source_region"); ok {
+		sourceRegion = v.(string)
+	}
+	metadataDirective := d.Get("metadata_directive").(string)
+
+	request := &s3.CopyObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		CopySource: aws.String(cosCopyObjectSource(d.Get("source_bucket").(string), d.Get("source_key").(string),
+			sourceRegion, d.Get("source_version_id").(string))),
+		MetadataDirective: aws.String(metadataDirective),
+		ACL:               aws.String(d.Get("acl").(string)),
+	}
+	if v, ok := d.GetOk("storage_class"); ok {
+		request.StorageClass = aws.String(v.(string))
+	}
+
+	replaced := false
+	if v, ok := d.GetOk("cache_control"); ok {
+		request.CacheControl = aws.String(v.(string))
+		replaced = true
+	}
+	if v, ok := d.GetOk("content_disposition"); ok {
+		request.ContentDisposition = aws.String(v.(string))
+		replaced = true
+	}
+	if v, ok := d.GetOk("content_encoding"); ok {
+		request.ContentEncoding = aws.String(v.(string))
+		replaced = true
+	}
+	if v, ok := d.GetOk("content_type"); ok {
+		request.ContentType = aws.String(v.(string))
+		replaced = true
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		request.Metadata = make(map[string]*string)
+		for k, value := range v.(map[string]interface{}) {
+			request.Metadata[k] = aws.String(value.(string))
+		}
+		replaced = true
+	}
+	// the computed values of the former copy are kept in the state, they are only checked on creation
+	if replaced && metadataDirective != tencentCloudCosMetadataDirectiveReplaced && d.IsNewResource() {
+		return fmt.Errorf("the metadata arguments of the cos object copy only work with the %s metadata_directive",
+			tencentCloudCosMetadataDirectiveReplaced)
+	}
+	if metadataDirective != tencentCloudCosMetadataDirectiveReplaced {
+		request.CacheControl = nil
+		request.ContentDisposition = nil
+		request.ContentEncoding = nil
+		request.ContentType = nil
+		request.Metadata = nil
+	}
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	result, versionId, err := cosService.CopyObject(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(bucket + FILED_SP + key)
+	d.Set("source_region", sourceRegion)
+	d.Set("version_id", versionId)
+	if result != nil {
+		d.Set("etag", strings.Trim(aws.StringValue(result.ETag), `"`))
+	}
+	return resourceTencentCloudCosBucketObjectCopyRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	response, has, err := cosService.DescribeObject(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		log.Printf("[WARN]%s cos object %s in bucket %s is not found, remove it from the state\n", logId, key, bucket)
+		d.SetId("")
+		return nil
+	}
+
+	// the copy is overwritten by others, it is removed from the state so that it is copied again
+	etag := strings.Trim(aws.StringValue(response.ETag), `"`)
+	if oldEtag := d.Get("etag").(string); oldEtag != "" && oldEtag != etag {
+		log.Printf("[WARN]%s cos object %s in bucket %s is changed, the ETag %s is not %s of the copy\n",
+			logId, key, bucket, etag, oldEtag)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cache_control", response.CacheControl)
+	d.Set("content_disposition", response.ContentDisposition)
+	d.Set("content_encoding", response.ContentEncoding)
+	d.Set("content_type", response.ContentType)
+	d.Set("etag", etag)
+	d.Set("version_id", response.VersionId)
+	d.Set("storage_class", s3.StorageClassStandard)
+	if response.StorageClass != nil {
+		d.Set("storage_class", response.StorageClass)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	fields := []string{
+		"source_bucket",
+		"source_key",
+		"source_region",
+		"source_version_id",
+		"source_etag",
+		"metadata_directive",
+		"cache_control",
+		"content_disposition",
+		"content_encoding",
+		"content_type",
+		"metadata",
+		"storage_class",
+	}
+	for _, key := range fields {
+		if d.HasChange(key) {
+			return resourceTencentCloudCosBucketObjectCopyCreate(d, meta)
+		}
+	}
+
+	if d.HasChange("acl") {
+		cosService := CosService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		err := cosService.PutObjectAcl(ctx, d.Get("bucket").(string), d.Get("key").(string), d.Get("acl").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudCosBucketObjectCopyRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := cosService.DeleteObject(ctx, d.Get("bucket").(string), d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
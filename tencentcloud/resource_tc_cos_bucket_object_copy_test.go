@@ -0,0 +1,146 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudCosBucketObjectCopy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosBucketObjectCopy(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketObjectExists("tencentcloud_cos_bucket_object_copy.copy"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "source_region", "ap-guangzhou"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "content_type", "text/plain"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "storage_class", "STANDARD"),
+					resource.TestCheckResourceAttrPair("tencentcloud_cos_bucket_object_copy.copy", "etag",
+						"tencentcloud_cos_bucket_object.source", "etag"),
+				),
+			},
+			// test copy again with the replaced metadata
+			{
+				Config: testAccCosBucketObjectCopyReplaced(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketObjectExists("tencentcloud_cos_bucket_object_copy.copy"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "metadata_directive", "Replaced"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "content_type", "text/html"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "storage_class", "STANDARD_IA"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object_copy.copy", "acl", "public-read"),
+				),
+			},
+			// test the overwritten copy is copied again
+			{
+				Config: testAccCosBucketObjectCopyReplaced(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketObjectCopyOverwrite("tencentcloud_cos_bucket_object_copy.copy"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCosBucketObjectCopyOverwrite(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("cos object copy %s is not found", n)
+		}
+		cosService := CosService{
+			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+		}
+		return cosService.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key"]),
+			Body:   strings.NewReader("bbbbbbbbbbbbbbbb"),
+		})
+	}
+}
+
+func testAccCheckCosBucketObjectCopyDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cosService := CosService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_cos_bucket_object_copy" {
+			continue
+		}
+
+		bucket := rs.Primary.Attributes["bucket"]
+		key := rs.Primary.Attributes["key"]
+		_, err := cosService.HeadObject(ctx, bucket, key)
+		if err == nil {
+			return fmt.Errorf("cos object copy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccCosBucketObjectCopyBase = `
+resource "tencentcloud_cos_bucket" "source_bucket" {
+	bucket = "tf-bucket-copy-source-%[1]s"
+}
+
+resource "tencentcloud_cos_bucket" "destination_bucket" {
+	bucket = "tf-bucket-copy-destination-%[1]s"
+}
+
+resource "tencentcloud_cos_bucket_object" "source" {
+	bucket = "${tencentcloud_cos_bucket.source_bucket.bucket}"
+	key = "builds/tf-object-source"
+	content = "aaaaaaaaaaaaaaaa"
+	content_type = "text/plain"
+}
+`
+
+func testAccCosBucketObjectCopy(appid string) string {
+	return fmt.Sprintf(testAccCosBucketObjectCopyBase+`
+resource "tencentcloud_cos_bucket_object_copy" "copy" {
+	bucket = "${tencentcloud_cos_bucket.destination_bucket.bucket}"
+	key = "releases/tf-object-copy"
+	source_bucket = "${tencentcloud_cos_bucket_object.source.bucket}"
+	source_key = "${tencentcloud_cos_bucket_object.source.key}"
+	source_etag = "${tencentcloud_cos_bucket_object.source.etag}"
+}
+`, appid)
+}
+
+func testAccCosBucketObjectCopyReplaced(appid string) string {
+	return fmt.Sprintf(testAccCosBucketObjectCopyBase+`
+resource "tencentcloud_cos_bucket_object_copy" "copy" {
+	bucket = "${tencentcloud_cos_bucket.destination_bucket.bucket}"
+	key = "releases/tf-object-copy"
+	source_bucket = "${tencentcloud_cos_bucket_object.source.bucket}"
+	source_key = "${tencentcloud_cos_bucket_object.source.key}"
+	source_etag = "${tencentcloud_cos_bucket_object.source.etag}"
+	metadata_directive = "Replaced"
+	content_type = "text/html"
+	cache_control = "no-cache"
+	storage_class = "STANDARD_IA"
+	acl = "public-read"
+
+	metadata = {
+		"build" = "1024"
+	}
+}
+`, appid)
+}
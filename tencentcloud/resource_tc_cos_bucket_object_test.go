@@ -1,7 +1,9 @@
 package tencentcloud
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -63,6 +65,16 @@ func TestAccTencentCloudCosBucketObject_content(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_content", "content_type", "binary/octet-stream"),
 				),
 			},
+			{
+				ResourceName: "tencentcloud_cos_bucket_object.object_content",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["tencentcloud_cos_bucket_object.object_content"]
+					return rs.Primary.Attributes["bucket"] + FILED_SP + rs.Primary.Attributes["key"], nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "content"},
+			},
 		},
 	})
 }
@@ -109,6 +121,91 @@ func TestAccTencentCloudCosBucketObject_acl(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudCosBucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-test-cos-object-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	// Compatible with windows path format
+	path := tmpFile.Name()
+	if runtime.GOOS == "windows" {
+		path = strings.Replace(path, "\\", "\\\\", -1)
+	}
+
+	// the sources are larger than 3 parts of 1MB
+	source := bytes.Repeat([]byte("a"), 3*1024*1024+1)
+	sourceUpdate := bytes.Repeat([]byte("b"), 3*1024*1024+1)
+	writeSource := func(data []byte) {
+		if err := ioutil.WriteFile(tmpFile.Name(), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hash := fmt.Sprintf("%x", md5.Sum(source))
+	hashUpdate := fmt.Sprintf("%x", md5.Sum(sourceUpdate))
+	var etag string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeSource(source) },
+				Config:    testAccCosBucketObject_multipart(appid, path, hash),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketObjectExists("tencentcloud_cos_bucket_object.object_multipart"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cos_bucket_object.object_multipart", "etag"),
+					func(s *terraform.State) error {
+						etag = s.RootModule().Resources["tencentcloud_cos_bucket_object.object_multipart"].Primary.Attributes["etag"]
+						return nil
+					},
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_multipart", "source_hash", hash),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_multipart", "part_size", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_multipart", "upload_concurrency", "2"),
+				),
+			},
+			// the object is uploaded again when source_hash changes
+			{
+				PreConfig: func() { writeSource(sourceUpdate) },
+				Config:    testAccCosBucketObject_multipart(appid, path, hashUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketObjectExists("tencentcloud_cos_bucket_object.object_multipart"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_multipart", "source_hash", hashUpdate),
+					// the ETag of the new upload is stored
+					func(s *terraform.State) error {
+						newEtag := s.RootModule().Resources["tencentcloud_cos_bucket_object.object_multipart"].Primary.Attributes["etag"]
+						if newEtag == etag {
+							return fmt.Errorf("cos object etag is not refreshed after the source is uploaded again: %s", newEtag)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudCosBucketObject_versioning(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosBucketObject_versioning(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketObjectExists("tencentcloud_cos_bucket_object.object_versioning"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cos_bucket_object.object_versioning", "version_id"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_cos_bucket_object.object_versioning", "version_id",
+						"tencentcloud_cos_bucket_object.object_versioning", "version_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCosBucketObjectExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := GetLogId(nil)
@@ -215,3 +312,41 @@ resource "tencentcloud_cos_bucket_object" "object_acl" {
 }
 `, appid, acl)
 }
+
+func testAccCosBucketObject_multipart(appid, source, hash string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "object_bucket" {
+	bucket = "tf-bucket-multipart-%s"
+}
+
+resource "tencentcloud_cos_bucket_object" "object_multipart" {
+	bucket = "${tencentcloud_cos_bucket.object_bucket.bucket}"
+	key = "tf-object-multipart"
+	source = "%s"
+	source_hash = "%s"
+	part_size = 1
+	upload_concurrency = 2
+}
+`, appid, source, hash)
+}
+
+func testAccCosBucketObject_versioning(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "object_bucket" {
+	bucket = "tf-bucket-versioning-object-%s"
+	versioning_enabled = true
+}
+
+resource "tencentcloud_cos_bucket_object" "object_versioning" {
+	bucket = "${tencentcloud_cos_bucket.object_bucket.bucket}"
+	key = "tf-object-versioning"
+	content = "aaaaaaaaaaaaaaaa"
+}
+
+data "tencentcloud_cos_bucket_object" "object_versioning" {
+	bucket = "${tencentcloud_cos_bucket_object.object_versioning.bucket}"
+	key = "${tencentcloud_cos_bucket_object.object_versioning.key}"
+	version_id = "${tencentcloud_cos_bucket_object.object_versioning.version_id}"
+}
+`, appid)
+}
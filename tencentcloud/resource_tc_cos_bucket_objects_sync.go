@@ -0,0 +1,441 @@
+/*
+Provides a COS resource to sync the files of a local directory to a bucket, only the changed files are uploaded or deleted.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket = "mycos-1258798060"
+  acl    = "public-read"
+
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+  }
+}
+
+resource "tencentcloud_cos_bucket_objects_sync" "site" {
+  bucket     = "${tencentcloud_cos_bucket.mycos.bucket}"
+  source_dir = "path/to/site"
+  key_prefix = "static/"
+  include    = ["*.html", "*.css", "*.js", "images/*"]
+  exclude    = [".*"]
+  acl        = "public-read"
+
+  file_rules {
+    extension     = ".html"
+    content_type  = "text/html; charset=utf-8"
+    cache_control = "no-cache"
+  }
+
+  file_rules {
+    extension     = ".js"
+    cache_control = "max-age=31536000"
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceTencentCloudCosBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceTencentCloudCosBucketObjectsSyncCreate,
+		Read:          resourceTencentCloudCosBucketObjectsSyncRead,
+		Update:        resourceTencentCloudCosBucketObjectsSyncUpdate,
+		Delete:        resourceTencentCloudCosBucketObjectsSyncDelete,
+		CustomizeDiff: resourceTencentCloudCosBucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the bucket to sync to.",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the local directory to sync.",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix prepended to the relative paths of the files to make the object keys, such as `static/`.",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files to sync, all the files are synced by default. A pattern without `/` matches the file name, otherwise it matches the slash separated path relative to `source_dir`.",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files not to sync, in the same format as `include`.",
+			},
+			"file_rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Object metadata rules matched by the file extension (documented below).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extension": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "File extension the rule applies to, such as `.html`.",
+						},
+						"content_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A standard MIME type of the objects, it is guessed from the extension by default.",
+						},
+						"cache_control": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies caching behavior of the objects.",
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.ObjectCannedACLPrivate,
+				ValidateFunc: validateAllowedStringValue([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+				}),
+				Description: "The canned ACL of the objects. Available values include private, public-read, and public-read-write. Defaults to private.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      tencentCloudCosObjectUploadConcurrencyDefault,
+				ValidateFunc: validateIntegerInRange(1, 32),
+				Description:  "Number of objects uploaded or deleted in parallel. Default is 5.",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "MD5 sums of the synced objects keyed by the object keys, the plan shows the objects to be uploaded or deleted as its changes.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCosBucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	d.SetId(bucket + FILED_SP + keyPrefix)
+	return resourceTencentCloudCosBucketObjectsSyncUpdate(d, meta)
+}
+
+func resourceTencentCloudCosBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	objects, err := cosService.ListObjectsByPrefix(ctx, bucket, keyPrefix)
+	if err != nil {
+		return err
+	}
+	remote := make(map[string]string, len(objects))
+	for _, object := range objects {
+		remote[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+	}
+
+	// the objects deleted or modified out of terraform are uploaded again in the next apply
+	files := make(map[string]interface{})
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		etag, ok := remote[key]
+		if !ok {
+			continue
+		}
+		if strings.Contains(etag, "-") {
+			etag = hash.(string)
+		}
+		files[key] = etag
+	}
+	d.Set("files", files)
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	files, paths, err := cosObjectsSyncLocalFiles(d.Get("source_dir").(string), keyPrefix,
+		expandStringList(d.Get("include").([]interface{})), expandStringList(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	objects, err := cosService.ListObjectsByPrefix(ctx, bucket, keyPrefix)
+	if err != nil {
+		return err
+	}
+	remote := make(map[string]string, len(objects))
+	for _, object := range objects {
+		remote[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+	}
+
+	// the metadata of all the objects is changed with the rules
+	uploadAll := d.HasChange("file_rules") || d.HasChange("acl")
+	rules := d.Get("file_rules").([]interface{})
+	acl := d.Get("acl").(string)
+
+	tasks := make([]func() error, 0)
+	for key, hash := range files {
+		if !uploadAll && remote[key] == hash.(string) {
+			continue
+		}
+		key, filePath := key, paths[key]
+		tasks = append(tasks, func() error {
+			return cosObjectsSyncUpload(ctx, &cosService, bucket, key, filePath, acl, rules)
+		})
+	}
+	oldFiles, _ := d.GetChange("files")
+	for key := range oldFiles.(map[string]interface{}) {
+		if _, ok := files[key]; ok {
+			continue
+		}
+		if _, ok := remote[key]; !ok {
+			continue
+		}
+		key := key
+		tasks = append(tasks, func() error {
+			return cosService.DeleteObject(ctx, bucket, key)
+		})
+	}
+	log.Printf("[DEBUG]%s cos bucket objects sync (%s) has %d objects to upload or delete\n", logId, d.Id(), len(tasks))
+
+	err = cosObjectsSyncRun(tasks, d.Get("upload_concurrency").(int))
+	if err != nil {
+		return err
+	}
+	d.Set("files", files)
+
+	return resourceTencentCloudCosBucketObjectsSyncRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	tasks := make([]func() error, 0)
+	for key := range d.Get("files").(map[string]interface{}) {
+		key := key
+		tasks = append(tasks, func() error {
+			return cosService.DeleteObject(ctx, bucket, key)
+		})
+	}
+
+	return cosObjectsSyncRun(tasks, d.Get("upload_concurrency").(int))
+}
+
+func resourceTencentCloudCosBucketObjectsSyncCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "key_prefix", "include", "exclude"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	files, _, err := cosObjectsSyncLocalFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string),
+		expandStringList(d.Get("include").([]interface{})), expandStringList(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	old := d.Get("files").(map[string]interface{})
+	changed := len(old) != len(files)
+	for key, hash := range files {
+		if v, ok := old[key]; !ok || v.(string) != hash.(string) {
+			changed = true
+			break
+		}
+	}
+	if changed {
+		return d.SetNew("files", files)
+	}
+	return nil
+}
+
+// cosObjectsSyncLocalFiles returns the MD5 sums and the local paths of the files to sync keyed by the object keys
+func cosObjectsSyncLocalFiles(sourceDir, keyPrefix string, include, exclude []string) (files map[string]interface{}, paths map[string]string, errRet error) {
+	root, err := homedir.Expand(sourceDir)
+	if err != nil {
+		errRet = fmt.Errorf("cos objects sync source_dir (%s) homedir expand error: %s", sourceDir, err.Error())
+		return
+	}
+
+	files = make(map[string]interface{})
+	paths = make(map[string]string)
+	errRet = filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if len(include) > 0 && !cosObjectsSyncMatch(include, rel) {
+			return nil
+		}
+		if cosObjectsSyncMatch(exclude, rel) {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+
+		key := keyPrefix + rel
+		files[key] = hex.EncodeToString(hash.Sum(nil))
+		paths[key] = filePath
+		return nil
+	})
+	if errRet != nil {
+		errRet = fmt.Errorf("cos objects sync source_dir (%s) read error: %s", sourceDir, errRet.Error())
+	}
+	return
+}
+
+func cosObjectsSyncMatch(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func cosObjectsSyncUpload(ctx context.Context, cosService *CosService, bucket, key, filePath, acl string, rules []interface{}) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("cos objects sync file (%s) open error: %s", filePath, err.Error())
+	}
+	defer file.Close()
+
+	ext := path.Ext(key)
+	request := &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   file,
+		ACL:    aws.String(acl),
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		request.ContentType = aws.String(contentType)
+	}
+	for _, item := range rules {
+		rule := item.(map[string]interface{})
+		extension := rule["extension"].(string)
+		if !strings.HasPrefix(extension, ".") {
+			extension = "." + extension
+		}
+		if !strings.EqualFold(extension, ext) {
+			continue
+		}
+		if v := rule["content_type"].(string); v != "" {
+			request.ContentType = aws.String(v)
+		}
+		if v := rule["cache_control"].(string); v != "" {
+			request.CacheControl = aws.String(v)
+		}
+	}
+
+	return cosService.PutObject(ctx, request)
+}
+
+// cosObjectsSyncRun runs the tasks with concurrency workers and returns the first error
+func cosObjectsSyncRun(tasks []func() error, concurrency int) error {
+	taskChan := make(chan func() error, len(tasks))
+	for _, task := range tasks {
+		taskChan <- task
+	}
+	close(taskChan)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range taskChan {
+				mu.Lock()
+				failed := firstErr != nil
+				mu.Unlock()
+				if failed {
+					return
+				}
+				if err := task(); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
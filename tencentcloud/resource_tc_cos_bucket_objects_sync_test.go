@@ -0,0 +1,149 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudCosBucketObjectsSync(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "tf-test-cos-objects-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	writeFile := func(name, content string) {
+		filePath := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html>index</html>")
+	writeFile("js/app.js", "console.log('app')")
+	writeFile(".hidden", "hidden")
+
+	// Compatible with windows path format
+	path := tmpDir
+	if runtime.GOOS == "windows" {
+		path = strings.Replace(path, "\\", "\\\\", -1)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosBucketObjectsSync(appid, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_objects_sync.sync", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cos_bucket_objects_sync.sync", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cos_bucket_objects_sync.sync", "files.site/js/app.js"),
+					testAccCheckCosBucketObjectsSyncObject("tencentcloud_cos_bucket_objects_sync.sync", "site/index.html", "text/html; charset=utf-8", "no-cache"),
+				),
+			},
+			// test sync the changed files only
+			{
+				PreConfig: func() {
+					writeFile("js/app.js", "console.log('app updated')")
+					writeFile("about.html", "<html>about</html>")
+					if err := os.Remove(filepath.Join(tmpDir, "index.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCosBucketObjectsSync(appid, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_objects_sync.sync", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cos_bucket_objects_sync.sync", "files.site/about.html"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cos_bucket_objects_sync.sync", "files.site/js/app.js"),
+					resource.TestCheckNoResourceAttr("tencentcloud_cos_bucket_objects_sync.sync", "files.site/index.html"),
+					testAccCheckCosBucketObjectsSyncObject("tencentcloud_cos_bucket_objects_sync.sync", "site/about.html", "text/html; charset=utf-8", "no-cache"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCosBucketObjectsSyncObject(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("cos bucket objects sync %s is not found", n)
+		}
+		cosService := CosService{
+			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+		}
+		info, err := cosService.HeadObject(ctx, rs.Primary.Attributes["bucket"], key)
+		if err != nil {
+			return err
+		}
+		if pointerToString(info.ContentType) != contentType {
+			return fmt.Errorf("cos object %s content type is %s, expected %s", key, pointerToString(info.ContentType), contentType)
+		}
+		if pointerToString(info.CacheControl) != cacheControl {
+			return fmt.Errorf("cos object %s cache control is %s, expected %s", key, pointerToString(info.CacheControl), cacheControl)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCosBucketObjectsSyncDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cosService := CosService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_cos_bucket_objects_sync" {
+			continue
+		}
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "files.") || k == "files.%" {
+				continue
+			}
+			key := strings.TrimPrefix(k, "files.")
+			if _, err := cosService.HeadObject(ctx, rs.Primary.Attributes["bucket"], key); err == nil {
+				return fmt.Errorf("cos object still exists: %s", key)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCosBucketObjectsSync(appid, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "sync_bucket" {
+	bucket = "tf-bucket-sync-%s"
+}
+
+resource "tencentcloud_cos_bucket_objects_sync" "sync" {
+	bucket = "${tencentcloud_cos_bucket.sync_bucket.bucket}"
+	source_dir = "%s"
+	key_prefix = "site/"
+	exclude = [".*"]
+
+	file_rules {
+		extension = ".html"
+		content_type = "text/html; charset=utf-8"
+		cache_control = "no-cache"
+	}
+}
+`, appid, sourceDir)
+}
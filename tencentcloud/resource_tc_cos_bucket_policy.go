@@ -0,0 +1,152 @@
+/*
+Provides a COS resource to manage the access policy of a bucket.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_cos_bucket" "mycos" {
+  bucket = "mycos-1258798060"
+}
+
+resource "tencentcloud_cos_bucket_policy" "policy" {
+  bucket = "${tencentcloud_cos_bucket.mycos.bucket}"
+  policy = <<EOF
+{
+  "version": "2.0",
+  "Statement": [
+    {
+      "Principal": {
+        "qcs": ["qcs::cam::uin/100000000001:uin/100000000002"]
+      },
+      "Effect": "allow",
+      "Action": ["name/cos:GetObject"],
+      "Resource": ["qcs::cos:ap-guangzhou:uid/1258798060:mycos-1258798060/*"]
+    }
+  ]
+}
+EOF
+}
+```
+
+Import
+
+COS bucket policy can be imported, e.g.
+
+```
+$ terraform import tencentcloud_cos_bucket_policy.policy bucket-name
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTencentCloudCosBucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCosBucketPolicyCreate,
+		Read:   resourceTencentCloudCosBucketPolicyRead,
+		Update: resourceTencentCloudCosBucketPolicyUpdate,
+		Delete: resourceTencentCloudCosBucketPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCosBucketName,
+				Description:  "The name of the bucket.",
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				Description:      "The text of the policy, a JSON document. Equivalent documents formatted differently are not treated as changes.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCosBucketPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Get("bucket").(string)
+	policy := d.Get("policy").(string)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := cosService.PutBucketPolicy(ctx, bucket, policy)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(bucket)
+	return resourceTencentCloudCosBucketPolicyRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	bucket := d.Id()
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	policy, has, err := cosService.GetBucketPolicy(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if !has {
+		d.SetId("")
+		return nil
+	}
+
+	// keep the configured document if COS returns an equivalent one
+	if suppressEquivalentJsonDiffs("policy", d.Get("policy").(string), policy, d) {
+		policy = d.Get("policy").(string)
+	}
+	d.Set("bucket", bucket)
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceTencentCloudCosBucketPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	if d.HasChange("policy") {
+		cosService := CosService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		err := cosService.PutBucketPolicy(ctx, d.Id(), d.Get("policy").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudCosBucketPolicyRead(d, meta)
+}
+
+func resourceTencentCloudCosBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cosService := CosService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := cosService.DeleteBucketPolicy(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
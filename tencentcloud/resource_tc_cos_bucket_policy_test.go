@@ -0,0 +1,118 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudCosBucketPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosBucketPolicy(appid, "name/cos:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketPolicyExists("tencentcloud_cos_bucket_policy.policy"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_policy.policy", "bucket", "tf-bucket-policy-"+appid),
+					resource.TestMatchResourceAttr("tencentcloud_cos_bucket_policy.policy", "policy", regexp.MustCompile("name/cos:GetObject")),
+				),
+			},
+			// test update bucket policy
+			{
+				Config: testAccCosBucketPolicy(appid, "name/cos:HeadObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketPolicyExists("tencentcloud_cos_bucket_policy.policy"),
+					resource.TestMatchResourceAttr("tencentcloud_cos_bucket_policy.policy", "policy", regexp.MustCompile("name/cos:HeadObject")),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket_policy.policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func testAccCheckCosBucketPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("cos bucket policy %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("cos bucket policy id is not set")
+		}
+		cosService := CosService{
+			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+		}
+		_, has, err := cosService.GetBucketPolicy(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return fmt.Errorf("cos bucket policy %s is not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCosBucketPolicyDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	cosService := CosService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_cos_bucket_policy" {
+			continue
+		}
+		_, has, err := cosService.GetBucketPolicy(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("cos bucket policy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCosBucketPolicy(appid, action string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_policy" {
+	bucket = "tf-bucket-policy-%[1]s"
+}
+
+resource "tencentcloud_cos_bucket_policy" "policy" {
+	bucket = "${tencentcloud_cos_bucket.bucket_policy.bucket}"
+	policy = <<EOF
+{
+  "version": "2.0",
+  "Statement": [
+    {
+      "Principal": {
+        "qcs": ["qcs::cam::anyone:anyone"]
+      },
+      "Effect": "allow",
+      "Action": ["%[2]s"],
+      "Resource": ["qcs::cos:ap-guangzhou:uid/%[1]s:tf-bucket-policy-%[1]s/*"]
+    }
+  ]
+}
+EOF
+}
+`, appid, action)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -207,6 +209,13 @@ func TestAccTencentCloudCosBucket_website(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.#", "1"),
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.index_document", "testindex.html"),
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.error_document", "testerror.html"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.redirect_all_requests_to", "https"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.routing_rules.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.routing_rules.0.condition_error_code", "404"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.routing_rules.0.redirect_replace_key", "404.html"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.routing_rules.1.condition_prefix", "docs/"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.routing_rules.1.redirect_protocol", "https"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_website", "website.0.routing_rules.1.redirect_replace_key_prefix", "documents/"),
 				),
 			},
 			{
@@ -219,6 +228,207 @@ func TestAccTencentCloudCosBucket_website(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudCosBucket_referer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucket_referer(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_referer"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.allow_domains.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.allow_domains.0", "*.example.com"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.allow_domains.1", "example.org"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.allow_empty_referer", "true"),
+				),
+			},
+			// test update bucket referer
+			{
+				Config: testAccBucket_refererUpdate(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_referer"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.allow_domains.#", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.deny_domains.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.deny_domains.0", "*.example.net"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.0.allow_empty_referer", "false"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket.bucket_referer",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl"},
+			},
+			// test disable bucket referer
+			{
+				Config: testAccBucket_refererDisabled(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_referer"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_referer", "referer.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudCosBucket_versioning(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucket_versioning(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_versioning"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "versioning_enabled", "true"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.0.non_current_transition.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.0.non_current_transition.1377917700.non_current_days", "30"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.0.non_current_transition.1377917700.storage_class", "STANDARD_IA"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.0.non_current_expiration.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.0.non_current_expiration.3643973238.non_current_days", "180"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket.bucket_versioning",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl"},
+			},
+			// test suspend bucket versioning
+			{
+				Config: testAccBucket_versioningSuspended(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_versioning"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "versioning_enabled", "false"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_versioning", "lifecycle_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudCosBucket_objectLock(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucket_objectLock(appid, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_object_lock"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_object_lock", "versioning_enabled", "true"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_object_lock", "object_lock.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_object_lock", "object_lock.0.retention_mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_object_lock", "object_lock.0.retention_days", "1"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket.bucket_object_lock",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl"},
+			},
+			// test update the retention of bucket object lock
+			{
+				Config: testAccBucket_objectLock(appid, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_object_lock"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_object_lock", "object_lock.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_object_lock", "object_lock.0.retention_days", "7"),
+				),
+			},
+			// the object lock can't be disabled
+			{
+				Config:      testAccBucket_objectLockRemoved(appid),
+				ExpectError: regexp.MustCompile("can not be disabled once it is enabled"),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudCosBucket_tagsLoggingEncryption(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucket_tagsLoggingEncryption(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_full"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "tags.%", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "tags.team", "audit"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "tags.env", "test"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "logging.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "logging.0.target_bucket", "tf-bucket-log-"+appid),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "logging.0.target_prefix", "access/"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "encryption.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "encryption.0.sse_algorithm", "AES256"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket.bucket_full",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl"},
+			},
+			// test remove tags, logging and encryption
+			{
+				Config: testAccBucket_tagsLoggingEncryptionUpdate(appid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_full"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "tags.%", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "logging.#", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_full", "encryption.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudCosBucket_replication(t *testing.T) {
+	ownerUin := os.Getenv("TENCENTCLOUD_OWNER_UIN")
+	if ownerUin == "" {
+		t.Skip("TENCENTCLOUD_OWNER_UIN must be set for the replication role")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucket_replication(appid, ownerUin, "STANDARD_IA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_replication"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.role", fmt.Sprintf("qcs::cam::uin/%s:uin/%s", ownerUin, ownerUin)),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.rules.0.id", "tf-replication"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.rules.0.status", "Enabled"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.rules.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.rules.0.destination_storage_class", "STANDARD_IA"),
+				),
+			},
+			// test update bucket replication
+			{
+				Config: testAccBucket_replication(appid, ownerUin, "STANDARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCosBucketExists("tencentcloud_cos_bucket.bucket_replication"),
+					resource.TestCheckResourceAttr("tencentcloud_cos_bucket.bucket_replication", "replication.0.rules.0.destination_storage_class", "STANDARD"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCosBucketExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := GetLogId(nil)
@@ -377,7 +587,172 @@ resource "tencentcloud_cos_bucket" "bucket_website" {
 	website {
 		index_document = "testindex.html"
 		error_document = "testerror.html"
+		redirect_all_requests_to = "https"
+
+		routing_rules {
+			condition_error_code = "404"
+			redirect_replace_key = "404.html"
+		}
+
+		routing_rules {
+			condition_prefix = "docs/"
+			redirect_protocol = "https"
+			redirect_replace_key_prefix = "documents/"
+		}
+	}
+}
+`, appid)
+}
+
+func testAccBucket_referer(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_referer" {
+	bucket = "tf-bucket-referer-%s"
+
+	referer {
+		allow_domains = ["*.example.com", "example.org"]
+	}
+}
+`, appid)
+}
+
+func testAccBucket_refererUpdate(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_referer" {
+	bucket = "tf-bucket-referer-%s"
+
+	referer {
+		deny_domains = ["*.example.net"]
+		allow_empty_referer = false
+	}
+}
+`, appid)
+}
+
+func testAccBucket_refererDisabled(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_referer" {
+	bucket = "tf-bucket-referer-%s"
+}
+`, appid)
+}
+
+func testAccBucket_versioning(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_versioning" {
+	bucket = "tf-bucket-versioning-%s"
+	versioning_enabled = true
+
+	lifecycle_rules {
+		filter_prefix = "logs/"
+		non_current_transition {
+			non_current_days = 30
+			storage_class = "STANDARD_IA"
+		}
+		non_current_expiration {
+			non_current_days = 180
+		}
 	}
 }
 `, appid)
 }
+
+func testAccBucket_versioningSuspended(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_versioning" {
+	bucket = "tf-bucket-versioning-%s"
+	versioning_enabled = false
+}
+`, appid)
+}
+
+func testAccBucket_replication(appid, ownerUin, storageClass string) string {
+	return fmt.Sprintf(`
+provider "tencentcloud" {
+	alias = "shanghai"
+	region = "ap-shanghai"
+}
+
+resource "tencentcloud_cos_bucket" "bucket_replica" {
+	provider = "tencentcloud.shanghai"
+	bucket = "tf-bucket-replica-%[1]s"
+	versioning_enabled = true
+}
+
+resource "tencentcloud_cos_bucket" "bucket_replication" {
+	bucket = "tf-bucket-replication-%[1]s"
+	versioning_enabled = true
+
+	replication {
+		role = "qcs::cam::uin/%[2]s:uin/%[2]s"
+
+		rules {
+			id = "tf-replication"
+			prefix = "logs/"
+			destination_bucket = "qcs::cos:ap-shanghai::${tencentcloud_cos_bucket.bucket_replica.bucket}"
+			destination_storage_class = "%[3]s"
+		}
+	}
+}
+`, appid, ownerUin, storageClass)
+}
+
+func testAccBucket_objectLock(appid string, days int) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_object_lock" {
+	bucket = "tf-bucket-object-lock-%s"
+	versioning_enabled = true
+
+	object_lock {
+		retention_days = %d
+	}
+}
+`, appid, days)
+}
+
+func testAccBucket_objectLockRemoved(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_object_lock" {
+	bucket = "tf-bucket-object-lock-%s"
+	versioning_enabled = true
+}
+`, appid)
+}
+
+func testAccBucket_tagsLoggingEncryption(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_log" {
+	bucket = "tf-bucket-log-%[1]s"
+}
+
+resource "tencentcloud_cos_bucket" "bucket_full" {
+	bucket = "tf-bucket-full-%[1]s"
+
+	tags = {
+		"team" = "audit"
+		"env" = "test"
+	}
+
+	logging {
+		target_bucket = "${tencentcloud_cos_bucket.bucket_log.bucket}"
+		target_prefix = "access/"
+	}
+
+	encryption {
+		sse_algorithm = "AES256"
+	}
+}
+`, appid)
+}
+
+func testAccBucket_tagsLoggingEncryptionUpdate(appid string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_cos_bucket" "bucket_log" {
+	bucket = "tf-bucket-log-%[1]s"
+}
+
+resource "tencentcloud_cos_bucket" "bucket_full" {
+	bucket = "tf-bucket-full-%[1]s"
+}
+`, appid)
+}
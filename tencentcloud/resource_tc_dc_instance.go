@@ -0,0 +1,408 @@
+/*
+Provides a resource to apply for a DC (physical line) instance.
+
+~> **NOTE:** The application of the DC is reviewed offline, and the DC is available only after it is constructed by the operator.
+
+Example Usage
+
+```hcl
+data "tencentcloud_dc_access_points" "aps" {
+  region_id = "ap-guangzhou"
+}
+
+resource "tencentcloud_dc_instance" "main" {
+  name            = "dc-main"
+  access_point_id = "${data.tencentcloud_dc_access_points.aps.access_point_list.0.access_point_id}"
+  line_operator   = "ChinaTelecom"
+  location        = "Guangzhou Tianhe"
+  port_type       = "1000Base-LX"
+  bandwidth       = 1000
+
+  fault_report_contact_person = "ops"
+  fault_report_contact_phone  = "13800000000"
+}
+```
+
+Import
+
+DC instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dc_instance.main dc-kax48sg7
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTencentCloudDcInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudDcInstanceCreate,
+		Read:   resourceTencentCloudDcInstanceRead,
+		Update: resourceTencentCloudDcInstanceUpdate,
+		Delete: resourceTencentCloudDcInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the DC.",
+			},
+			"access_point_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Access point ID of the DC, it can be queried with the data source `tencentcloud_dc_access_points`.",
+			},
+			"line_operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(DC_LINE_OPERATORS),
+				Description:  "Operator of the DC, and available values include ChinaTelecom, ChinaMobile, ChinaUnicom, In-houseWiring, ChinaOther and InternationalOperator.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location of the data center of the client.",
+			},
+			"port_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(DC_PORT_TYPES),
+				Description:  "Port type of the DC in client, and available values include 100Base-T, 1000Base-T, 1000Base-LX, 10GBase-T and 10GBase-LR. The default value is 1000Base-LX.",
+			},
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(2, 10240),
+				Description:  "Bandwidth of the DC in Mbps, and the range of values is [2-10240]. The default value is 1000.",
+			},
+			"redundant_dc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the redundant DC.",
+			},
+			"circuit_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The circuit code provided by the operator for the DC, and it can not be cleared once it is set.",
+			},
+			"vlan": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Debugging vlan of the DC, it is allocated automatically by default.",
+			},
+			"tencent_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Debugging interconnect IP of the DC within Tencent, it is allocated automatically by default, and it can not be cleared once it is set.",
+			},
+			"customer_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Debugging interconnect IP of the DC within client, it is allocated automatically by default, and it can not be cleared once it is set.",
+			},
+			"customer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Applicant name of the DC, the default is obtained from the account, and it can not be cleared once it is set.",
+			},
+			"customer_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Applicant email of the DC, the default is obtained from the account, and it can not be cleared once it is set.",
+			},
+			"customer_phone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Applicant phone number of the DC, the default is obtained from the account, and it can not be cleared once it is set.",
+			},
+			"fault_report_contact_person": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Contact of reporting a faulty, and it can not be cleared once it is set.",
+			},
+			"fault_report_contact_phone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Phone number of reporting a faulty, and it can not be cleared once it is set.",
+			},
+			// Computed values
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the DC, and available values include PENDING, REJECTED, TOPAY, PAID, ALLOCATED, AVAILABLE, DELETING and DELETED.",
+			},
+			"charge_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Charge type of the DC, and available values include NON_RECURRING_CHARGE and PREPAID_BY_YEAR.",
+			},
+			"enabled_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Enable time of resource.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of resource.",
+			},
+			"expired_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expire date of resource.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudDcInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dc_instance.create")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		name                           = d.Get("name").(string)
+		accessPointId                  = d.Get("access_point_id").(string)
+		lineOperator                   = d.Get("line_operator").(string)
+		location                       = d.Get("location").(string)
+		portType                       = ""
+		circuitCode                    = ""
+		redundantDcId                  = ""
+		tencentAddress                 = ""
+		customerAddress                = ""
+		customerName                   = ""
+		customerEmail                  = ""
+		customerPhone                  = ""
+		faultReportContactPerson       = ""
+		faultReportContactPhone        = ""
+		bandwidth                int64 = -1
+		vlan                     int64 = -1
+	)
+
+	if temp, ok := d.GetOk("port_type"); ok {
+		portType = temp.(string)
+	}
+	if temp, ok := d.GetOk("circuit_code"); ok {
+		circuitCode = temp.(string)
+	}
+	if temp, ok := d.GetOk("redundant_dc_id"); ok {
+		redundantDcId = temp.(string)
+	}
+	if temp, ok := d.GetOk("tencent_address"); ok {
+		tencentAddress = temp.(string)
+	}
+	if temp, ok := d.GetOk("customer_address"); ok {
+		customerAddress = temp.(string)
+	}
+	if temp, ok := d.GetOk("customer_name"); ok {
+		customerName = temp.(string)
+	}
+	if temp, ok := d.GetOk("customer_email"); ok {
+		customerEmail = temp.(string)
+	}
+	if temp, ok := d.GetOk("customer_phone"); ok {
+		customerPhone = temp.(string)
+	}
+	if temp, ok := d.GetOk("fault_report_contact_person"); ok {
+		faultReportContactPerson = temp.(string)
+	}
+	if temp, ok := d.GetOk("fault_report_contact_phone"); ok {
+		faultReportContactPhone = temp.(string)
+	}
+	if temp, ok := d.GetOk("bandwidth"); ok {
+		bandwidth = int64(temp.(int))
+	}
+	if temp, ok := d.GetOkExists("vlan"); ok {
+		vlan = int64(temp.(int))
+	}
+
+	dcId, err := service.CreateDirectConnect(ctx, name, accessPointId, lineOperator,
+		location, portType, circuitCode, redundantDcId,
+		tencentAddress, customerAddress,
+		customerName, customerEmail, customerPhone,
+		faultReportContactPerson, faultReportContactPhone,
+		bandwidth, vlan)
+	if err != nil {
+		return err
+	}
+	d.SetId(dcId)
+	return resourceTencentCloudDcInstanceRead(d, meta)
+}
+
+func resourceTencentCloudDcInstanceRead(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dc_instance.read")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		dcId = d.Id()
+	)
+
+	item, has, err := service.DescribeDirectConnect(ctx, dcId)
+
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", service.strPt2str(item.DirectConnectName))
+	d.Set("access_point_id", service.strPt2str(item.AccessPointId))
+	d.Set("line_operator", service.strPt2str(item.LineOperator))
+	d.Set("location", service.strPt2str(item.Location))
+	d.Set("port_type", service.strPt2str(item.PortType))
+	d.Set("bandwidth", service.int64Pt2int64(item.Bandwidth))
+	d.Set("redundant_dc_id", service.strPt2str(item.RedundantDirectConnectId))
+	d.Set("circuit_code", service.strPt2str(item.CircuitCode))
+	d.Set("vlan", service.int64Pt2int64(item.Vlan))
+	d.Set("tencent_address", service.strPt2str(item.TencentAddress))
+	d.Set("customer_address", service.strPt2str(item.CustomerAddress))
+	d.Set("customer_name", service.strPt2str(item.CustomerName))
+	d.Set("customer_email", service.strPt2str(item.CustomerContactMail))
+	d.Set("customer_phone", service.strPt2str(item.CustomerContactNumber))
+	d.Set("fault_report_contact_person", service.strPt2str(item.FaultReportContactPerson))
+	d.Set("fault_report_contact_phone", service.strPt2str(item.FaultReportContactNumber))
+
+	d.Set("state", strings.ToUpper(service.strPt2str(item.State)))
+	d.Set("charge_type", service.strPt2str(item.ChargeType))
+	d.Set("enabled_time", service.strPt2str(item.EnabledTime))
+	d.Set("create_time", service.strPt2str(item.CreatedTime))
+	d.Set("expired_time", service.strPt2str(item.ExpiredTime))
+
+	return nil
+}
+
+func resourceTencentCloudDcInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dc_instance.update")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		dcId                           = d.Id()
+		name                           = ""
+		circuitCode                    = ""
+		tencentAddress                 = ""
+		customerAddress                = ""
+		customerName                   = ""
+		customerEmail                  = ""
+		customerPhone                  = ""
+		faultReportContactPerson       = ""
+		faultReportContactPhone        = ""
+		vlan                     int64 = -1
+	)
+
+	// an empty value means unchanged to ModifyDirectConnectAttribute, so these values can't be cleared
+	for _, key := range []string{
+		"circuit_code",
+		"tencent_address",
+		"customer_address",
+		"customer_name",
+		"customer_email",
+		"customer_phone",
+		"fault_report_contact_person",
+		"fault_report_contact_phone",
+	} {
+		if d.HasChange(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf("%s of dc instance %s can not be cleared once it is set", key, dcId)
+		}
+	}
+
+	if d.HasChange("name") {
+		name = d.Get("name").(string)
+	}
+	if d.HasChange("circuit_code") {
+		circuitCode = d.Get("circuit_code").(string)
+	}
+	if d.HasChange("tencent_address") {
+		tencentAddress = d.Get("tencent_address").(string)
+	}
+	if d.HasChange("customer_address") {
+		customerAddress = d.Get("customer_address").(string)
+	}
+	if d.HasChange("customer_name") {
+		customerName = d.Get("customer_name").(string)
+	}
+	if d.HasChange("customer_email") {
+		customerEmail = d.Get("customer_email").(string)
+	}
+	if d.HasChange("customer_phone") {
+		customerPhone = d.Get("customer_phone").(string)
+	}
+	if d.HasChange("fault_report_contact_person") {
+		faultReportContactPerson = d.Get("fault_report_contact_person").(string)
+	}
+	if d.HasChange("fault_report_contact_phone") {
+		faultReportContactPhone = d.Get("fault_report_contact_phone").(string)
+	}
+	if d.HasChange("vlan") {
+		vlan = int64(d.Get("vlan").(int))
+	}
+
+	err := service.ModifyDirectConnectAttribute(ctx, dcId, name, circuitCode,
+		tencentAddress, customerAddress,
+		customerName, customerEmail, customerPhone,
+		faultReportContactPerson, faultReportContactPhone,
+		vlan)
+	if err != nil {
+		return err
+	}
+	return resourceTencentCloudDcInstanceRead(d, meta)
+}
+
+func resourceTencentCloudDcInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dc_instance.delete")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	return service.DeleteDirectConnect(ctx, d.Id())
+}
@@ -0,0 +1,114 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudDcInstanceBasic(t *testing.T) {
+	accessPointId := os.Getenv("TENCENTCLOUD_DC_ACCESS_POINT_ID")
+	if accessPointId == "" {
+		t.Skip("TENCENTCLOUD_DC_ACCESS_POINT_ID must be set to an access point to apply for the DC")
+	}
+
+	keyName := "tencentcloud_dc_instance.main"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDcInstanceConfig, accessPointId, "ci-temp-test-dc", "ops"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcInstanceExists(keyName),
+					resource.TestCheckResourceAttr(keyName, "name", "ci-temp-test-dc"),
+					resource.TestCheckResourceAttr(keyName, "access_point_id", accessPointId),
+					resource.TestCheckResourceAttr(keyName, "line_operator", "ChinaTelecom"),
+					resource.TestCheckResourceAttr(keyName, "fault_report_contact_person", "ops"),
+					resource.TestCheckResourceAttrSet(keyName, "state"),
+					resource.TestCheckResourceAttrSet(keyName, "create_time"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(testAccDcInstanceConfig, accessPointId, "ci-temp-test-dc-update", "ops-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcInstanceExists(keyName),
+					resource.TestCheckResourceAttr(keyName, "name", "ci-temp-test-dc-update"),
+					resource.TestCheckResourceAttr(keyName, "fault_report_contact_person", "ops-update"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccDcInstanceConfig, accessPointId, "ci-temp-test-dc-update", ""),
+				ExpectError: regexp.MustCompile("can not be cleared once it is set"),
+			},
+		},
+	})
+}
+
+func testAccCheckDcInstanceExists(r string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("resource %s is not found", r)
+		}
+
+		service := DcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+		_, has, err := service.DescribeDirectConnect(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has > 0 {
+			return nil
+		}
+		return fmt.Errorf("dc instance not exists.")
+	}
+}
+
+func testAccCheckDcInstanceDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_dc_instance" {
+			continue
+		}
+		_, has, err := service.DescribeDirectConnect(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has == 0 {
+			return nil
+		}
+		return fmt.Errorf("dc instance not delete ok")
+	}
+	return nil
+}
+
+const testAccDcInstanceConfig = `
+resource tencentcloud_dc_instance main {
+	access_point_id = "%s"
+	name = "%s"
+	line_operator = "ChinaTelecom"
+	location = "Guangzhou Tianhe"
+	port_type = "1000Base-LX"
+	bandwidth = 1000
+	fault_report_contact_person = "%s"
+	fault_report_contact_phone = "13800000000"
+}
+`
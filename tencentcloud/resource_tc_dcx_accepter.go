@@ -0,0 +1,160 @@
+/*
+Provides a resource for the owner of a DC to accept or reject a dedicated tunnel shared from another account.
+
+~> **NOTE:** The acceptance or rejection can not be undone, destroying the resource only removes it from the state.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_dcx_accepter" "shared" {
+  dcx_id = "dcx-2aklnmut"
+  action = "ACCEPT"
+}
+```
+
+Import
+
+The dedicated tunnel accepter can be imported using the id of the dedicated tunnel, e.g.
+
+```
+$ terraform import tencentcloud_dcx_accepter.shared dcx-2aklnmut
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTencentCloudDcxAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudDcxAccepterCreate,
+		Read:   resourceTencentCloudDcxAccepterRead,
+		Delete: resourceTencentCloudDcxAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudDcxAccepterImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dcx_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the dedicated tunnel shared from another account.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      DCX_SHARE_ACTION_ACCEPT,
+				ValidateFunc: validateAllowedStringValue(DCX_SHARE_ACTIONS),
+				Description:  "Action to the shared dedicated tunnel, and available values include ACCEPT and REJECT. The default value is ACCEPT.",
+			},
+			// Computed values
+			"dc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the DC the dedicated tunnel belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the dedicated tunnel.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the dedicated tunnels, and available values include PENDING, ALLOCATING, ALLOCATED, ALTERING, DELETING, DELETED, COMFIRMING and REJECTED.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudDcxAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dcx_accepter.create")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		dcxId  = d.Get("dcx_id").(string)
+		action = d.Get("action").(string)
+		err    error
+	)
+
+	if action == DCX_SHARE_ACTION_REJECT {
+		err = service.RejectDirectConnectTunnel(ctx, dcxId)
+	} else {
+		err = service.AcceptDirectConnectTunnel(ctx, dcxId)
+	}
+	if err != nil {
+		return err
+	}
+	d.SetId(dcxId)
+	return resourceTencentCloudDcxAccepterRead(d, meta)
+}
+
+func resourceTencentCloudDcxAccepterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("dcx_id", d.Id())
+	d.Set("action", DCX_SHARE_ACTION_ACCEPT)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTencentCloudDcxAccepterRead(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dcx_accepter.read")()
+
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		dcxId = d.Id()
+	)
+
+	item, has, err := service.DescribeDirectConnectTunnel(ctx, dcxId)
+
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		// the rejected tunnel is removed by the applicant, which is the expected result
+		if d.Get("action").(string) == DCX_SHARE_ACTION_REJECT {
+			d.Set("state", "DELETED")
+			return nil
+		}
+		d.SetId("")
+		return nil
+	}
+
+	var state = strings.ToUpper(service.strPt2str(item.State))
+	d.Set("dcx_id", dcxId)
+	d.Set("dc_id", service.strPt2str(item.DirectConnectId))
+	d.Set("name", service.strPt2str(item.DirectConnectTunnelName))
+	d.Set("state", state)
+	if state == "REJECTED" {
+		d.Set("action", DCX_SHARE_ACTION_REJECT)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudDcxAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+
+	logId := GetLogId(nil)
+
+	defer LogElapsed(logId + "resource.tencentcloud_dcx_accepter.delete")()
+
+	// the acceptance or rejection can not be undone, so it is only removed from the state
+	return nil
+}
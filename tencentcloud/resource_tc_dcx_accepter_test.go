@@ -0,0 +1,74 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudDcxAccepterBasic(t *testing.T) {
+	dcxId := os.Getenv("TENCENTCLOUD_DCX_SHARED_ID")
+	if dcxId == "" {
+		t.Skip("TENCENTCLOUD_DCX_SHARED_ID must be set to a dedicated tunnel shared from another account")
+	}
+
+	keyName := "tencentcloud_dcx_accepter.shared"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDcxAccepterConfig, dcxId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcxAccepterExists(keyName),
+					resource.TestCheckResourceAttr(keyName, "dcx_id", dcxId),
+					resource.TestCheckResourceAttr(keyName, "action", "ACCEPT"),
+					resource.TestCheckResourceAttrSet(keyName, "dc_id"),
+					resource.TestCheckResourceAttrSet(keyName, "name"),
+					resource.TestCheckResourceAttrSet(keyName, "state"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcxAccepterExists(r string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("resource %s is not found", r)
+		}
+
+		service := DcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+		info, has, err := service.DescribeDirectConnectTunnel(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has == 0 {
+			return fmt.Errorf("dcx not exists.")
+		}
+		if state := service.strPt2str(info.State); state == "PENDING" || state == "COMFIRMING" {
+			return fmt.Errorf("dcx %s is not accepted, state is %s", rs.Primary.ID, state)
+		}
+		return nil
+	}
+}
+
+const testAccDcxAccepterConfig = `
+resource tencentcloud_dcx_accepter shared {
+	dcx_id = "%s"
+}
+`
@@ -0,0 +1,308 @@
+/*
+Provides a mysql instance resource to create cross-region disaster recovery database instances.
+
+~> **NOTE:** The terminate operation of mysql does NOT take effect immediately，maybe takes for several hours. so during that time, VPCs associated with that mysql instance can't be terminated also.
+
+~> **NOTE:** The disaster recovery instance is created in the region of the provider, while its master instance lives in `master_region`.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_mysql_dr_instance" "default" {
+  master_instance_id = "cdb-dnqksd9f"
+  master_region      = "ap-guangzhou"
+  availability_zone  = "ap-shanghai-2"
+  instance_name      = "myTestDrMysql"
+  mem_size           = 128000
+  volume_size        = 255
+  vpc_id             = "vpc-12mt3l31"
+  subnet_id          = "subnet-9uivyb1g"
+  intranet_port      = 3306
+  security_groups    = ["sg-ot8eclwz"]
+  tags = {
+    name = "test"
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+)
+
+func resourceTencentCloudMysqlDrInstance() *schema.Resource {
+	drInstanceInfo := map[string]*schema.Schema{
+		"master_instance_id": {
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+			Description: "Indicates the master instance ID of the disaster recovery instance.",
+		},
+		"master_region": {
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+			Description: "Region of the master instance, such as ap-guangzhou.",
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Optional:    true,
+			Computed:    true,
+			Description: "Indicates which availability zone will be used.",
+		},
+
+		// Computed values
+		"sync_status": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Replication status between the disaster recovery instance and its master instance.",
+		},
+		"master_zone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Availability zone of the master instance.",
+		},
+	}
+
+	basic := TencentMsyqlBasicInfo()
+	for k, v := range basic {
+		drInstanceInfo[k] = v
+	}
+
+	return &schema.Resource{
+		Create: resourceTencentCloudMysqlDrInstanceCreate,
+		Read:   resourceTencentCloudMysqlDrInstanceRead,
+		Update: resourceTencentCloudMysqlDrInstanceUpdate,
+		Delete: resourceTencentCloudMysqlDrInstanceDelete,
+
+		Schema: drInstanceInfo,
+	}
+}
+
+func mysqlCreateDrInstancePayByMonth(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(ctx)
+
+	request := cdb.NewCreateDBInstanceRequest()
+	instanceRole := "dr"
+	request.InstanceRole = &instanceRole
+
+	period := int64(d.Get("period").(int))
+	request.Period = &period
+
+	autoRenewFlag := int64(d.Get("auto_renew_flag").(int))
+	request.AutoRenewFlag = &autoRenewFlag
+
+	masterInstanceId := d.Get("master_instance_id").(string)
+	request.MasterInstanceId = &masterInstanceId
+
+	masterRegion := d.Get("master_region").(string)
+	request.MasterRegion = &masterRegion
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		request.Zone = stringToPointer(v.(string))
+	}
+
+	if err := mysqlAllInstanceRoleSet(ctx, request, d, meta); err != nil {
+		return err
+	}
+
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstance(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	} else {
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	}
+	if len(response.Response.InstanceIds) != 1 {
+		return fmt.Errorf("mysql CreateDBInstance return len(InstanceIds) is not 1,but %d", len(response.Response.InstanceIds))
+	}
+	d.SetId(*response.Response.InstanceIds[0])
+	return nil
+}
+
+func mysqlCreateDrInstancePayByUse(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	logId := GetLogId(ctx)
+
+	request := cdb.NewCreateDBInstanceHourRequest()
+	instanceRole := "dr"
+	request.InstanceRole = &instanceRole
+
+	masterInstanceId := d.Get("master_instance_id").(string)
+	request.MasterInstanceId = &masterInstanceId
+
+	masterRegion := d.Get("master_region").(string)
+	request.MasterRegion = &masterRegion
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		request.Zone = stringToPointer(v.(string))
+	}
+
+	if err := mysqlAllInstanceRoleSet(ctx, request, d, meta); err != nil {
+		return err
+	}
+
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHour(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	} else {
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	}
+	if len(response.Response.InstanceIds) != 1 {
+		return fmt.Errorf("mysql CreateDBInstanceHour return len(InstanceIds) is not 1,but %d", len(response.Response.InstanceIds))
+	}
+	d.SetId(*response.Response.InstanceIds[0])
+	return nil
+}
+
+func resourceTencentCloudMysqlDrInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_mysql_dr_instance.create")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	payType := d.Get("pay_type").(int)
+	if payType == MysqlPayByMonth {
+		err := mysqlCreateDrInstancePayByMonth(ctx, d, meta)
+		if err != nil {
+			return err
+		}
+	} else if payType == MysqlPayByUse {
+		err := mysqlCreateDrInstancePayByUse(ctx, d, meta)
+		if err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("mysql not support this pay type yet.")
+	}
+
+	mysqlID := d.Id()
+
+	// a disaster recovery instance has to copy all data of its master across regions before running
+	err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if mysqlInfo == nil {
+			err = fmt.Errorf("mysqlid %s instance not exists", mysqlID)
+			return resource.NonRetryableError(err)
+		}
+		if *mysqlInfo.Status == MYSQL_STATUS_DELIVING {
+			return resource.RetryableError(fmt.Errorf("create mysql task  status is MYSQL_STATUS_DELIVING(%d)", MYSQL_STATUS_DELIVING))
+		}
+		if *mysqlInfo.Status == MYSQL_STATUS_RUNNING {
+			return nil
+		}
+		err = fmt.Errorf("create mysql task status is %d,we won't wait for it finish", *mysqlInfo.Status)
+		return resource.NonRetryableError(err)
+	})
+
+	if err != nil {
+		log.Printf("[CRITAL]%s create mysql  task fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudMysqlDrInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMysqlDrInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_mysql_dr_instance.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlInfo, err := tencentMsyqlBasicInfoRead(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+	if mysqlInfo == nil {
+		d.SetId("")
+		return nil
+	}
+	if mysqlInfo.Zone != nil {
+		d.Set("availability_zone", *mysqlInfo.Zone)
+	}
+	masterInfo := mysqlInfo.MasterInfo
+	if masterInfo == nil || masterInfo.InstanceId == nil || masterInfo.Region == nil {
+		return fmt.Errorf("mysql dr instance %s has no master instance info", d.Id())
+	}
+	d.Set("master_instance_id", *masterInfo.InstanceId)
+	d.Set("master_region", *masterInfo.Region)
+	if masterInfo.Zone != nil {
+		d.Set("master_zone", *masterInfo.Zone)
+	}
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+	drInfo, err := mysqlService.DescribeDrInfoOfInstance(ctx, *masterInfo.Region, *masterInfo.InstanceId, d.Id())
+	if err != nil {
+		return err
+	}
+	if drInfo != nil && drInfo.SyncStatus != nil {
+		d.Set("sync_status", int(*drInfo.SyncStatus))
+	}
+
+	return nil
+}
+
+func resourceTencentCloudMysqlDrInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_mysql_dr_instance.update")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	payType := d.Get("pay_type").(int)
+
+	d.Partial(true)
+
+	err := mysqlAllInstanceRoleUpdate(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	if payType == MysqlPayByMonth {
+		if d.HasChange("auto_renew_flag") {
+			renewFlag := int64(d.Get("auto_renew_flag").(int))
+			mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+			if err := mysqlService.ModifyAutoRenewFlag(ctx, d.Id(), renewFlag); err != nil {
+				return err
+			}
+			d.SetPartial("auto_renew_flag")
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudMysqlDrInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMysqlDrInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_mysql_dr_instance.delete")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	_, err := mysqlService.IsolateDBInstance(ctx, d.Id())
+
+	if err != nil {
+		return err
+	}
+	return nil
+}
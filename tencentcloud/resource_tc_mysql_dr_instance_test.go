@@ -0,0 +1,99 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+func TestAccTencentCloudMysqlDrInstance(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMysqlDrInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlDrInstance("mysql-dr-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMysqlInstanceExists("tencentcloud_mysql_dr_instance.mysql_dr"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_dr_instance.mysql_dr", "instance_name", "mysql-dr-test"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_dr_instance.mysql_dr", "master_region", "ap-guangzhou"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_dr_instance.mysql_dr", "availability_zone", "ap-shanghai-2"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_dr_instance.mysql_dr", "mem_size", "1000"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_dr_instance.mysql_dr", "volume_size", "50"),
+					resource.TestCheckResourceAttrSet("tencentcloud_mysql_dr_instance.mysql_dr", "master_zone"),
+					resource.TestCheckResourceAttrSet("tencentcloud_mysql_dr_instance.mysql_dr", "sync_status"),
+					resource.TestCheckResourceAttrSet("tencentcloud_mysql_dr_instance.mysql_dr", "intranet_ip"),
+				),
+			},
+			// update instance_name
+			{
+				Config: testAccMysqlDrInstance("mysql-dr-update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMysqlInstanceExists("tencentcloud_mysql_dr_instance.mysql_dr"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_dr_instance.mysql_dr", "instance_name", "mysql-dr-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMysqlDrInstanceDestroy(s *terraform.State) error {
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	mysqlService := MysqlService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_mysql_dr_instance" {
+			continue
+		}
+		instance, err := mysqlService.DescribeRunningDBInstanceById(ctx, rs.Primary.ID)
+		if instance != nil {
+			return fmt.Errorf("mysql instance still exist")
+		}
+		if err != nil {
+			sdkErr, ok := err.(*errors.TencentCloudSDKError)
+			if ok && sdkErr.Code == MysqlInstanceIdNotFound {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccMysqlDrInstance(instanceName string) string {
+	return fmt.Sprintf(`
+provider "tencentcloud" {
+	region = "ap-shanghai"
+}
+
+provider "tencentcloud" {
+	alias  = "guangzhou"
+	region = "ap-guangzhou"
+}
+
+resource "tencentcloud_mysql_instance" "master" {
+	provider          = "tencentcloud.guangzhou"
+	mem_size          = 1000
+	volume_size       = 50
+	instance_name     = "testAccMysqlDrMaster"
+	engine_version    = "5.7"
+	root_password     = "test1234"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_mysql_dr_instance" "mysql_dr" {
+	master_instance_id = "${tencentcloud_mysql_instance.master.id}"
+	master_region      = "ap-guangzhou"
+	availability_zone  = "ap-shanghai-2"
+	mem_size           = 1000
+	volume_size        = 50
+	instance_name      = "%s"
+}
+	`, instanceName)
+}
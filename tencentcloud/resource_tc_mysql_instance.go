@@ -224,6 +224,11 @@ func resourceTencentCloudMysqlInstance() *schema.Resource {
 			Optional:    true,
 			Description: "Project ID, default value is 0.",
 		},
+		"max_price": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "The maximum price (in CNY) acceptable for the instance. If set, the price is inquired before purchasing or upgrading the instance, and the creation or upgrade fails when it is higher than this value. It is compared with the price of the whole period for a prepaid instance and the hourly price for a postpaid one, and with the price of the upgrade when mem_size or volume_size changes.",
+		},
 
 		// Computed values
 		"internet_host": {
@@ -514,6 +519,58 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+func mysqlCheckMaxPrice(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	maxPrice, ok := d.GetOk("max_price")
+	if !ok {
+		return nil
+	}
+
+	zone := d.Get("availability_zone").(string)
+	if zone == "" {
+		return fmt.Errorf("availability_zone is required to inquire the price when max_price is set")
+	}
+	payType := MYSQL_CHARGE_TYPE_POSTPAID
+	if d.Get("pay_type").(int) == MysqlPayByMonth {
+		payType = MYSQL_CHARGE_TYPE_PREPAID
+	}
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+	price, _, err := mysqlService.DescribeDBPrice(ctx, zone,
+		int64(d.Get("mem_size").(int)),
+		int64(d.Get("volume_size").(int)),
+		payType,
+		int64(d.Get("period").(int)),
+		MYSQL_ROLE_MAP[1],
+		int64(d.Get("slave_sync_mode").(int)))
+	if err != nil {
+		return err
+	}
+	if float64(price)/100 > maxPrice.(float64) {
+		return fmt.Errorf("the inquired price %.2f of mysql instance is higher than max_price %.2f", float64(price)/100, maxPrice.(float64))
+	}
+	return nil
+}
+
+func mysqlCheckUpgradeMaxPrice(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	maxPrice, ok := d.GetOk("max_price")
+	if !ok {
+		return nil
+	}
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+	price, err := mysqlService.InquiryPriceUpgradeInstances(ctx, d.Id(),
+		uint64(d.Get("mem_size").(int)),
+		uint64(d.Get("volume_size").(int)),
+		uint64(d.Get("slave_sync_mode").(int)))
+	if err != nil {
+		return err
+	}
+	if float64(price)/100 > maxPrice.(float64) {
+		return fmt.Errorf("the inquired price %.2f of upgrading mysql instance %s is higher than max_price %.2f", float64(price)/100, d.Id(), maxPrice.(float64))
+	}
+	return nil
+}
+
 func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer LogElapsed("source.tencentcloud_mysql_instance.create")()
 
@@ -524,6 +581,10 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 
 	payType := d.Get("pay_type").(int)
 
+	if err := mysqlCheckMaxPrice(ctx, d, meta); err != nil {
+		return err
+	}
+
 	if payType == MysqlPayByMonth {
 		err := mysqlCreateInstancePayByMonth(ctx, d, meta)
 		if err != nil {
@@ -1148,6 +1209,12 @@ func resourceTencentCloudMysqlInstanceUpdate(d *schema.ResourceData, meta interf
 
 	payType := d.Get("pay_type").(int)
 
+	if d.HasChange("mem_size") || d.HasChange("volume_size") {
+		if err := mysqlCheckUpgradeMaxPrice(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(true)
 	if payType == MysqlPayByMonth {
 		err := mysqlUpdateInstancePayByMonth(ctx, d, meta)
@@ -15,6 +15,13 @@ resource "tencentcloud_mysql_readonly_instance" "default" {
   subnet_id = "subnet-9uivyb1g"
   intranet_port = 3306
   security_groups = ["sg-ot8eclwz"]
+  ro_group_mode = "allinone"
+  ro_group_name = "myTestGroup"
+  ro_group_weight_mode = "custom"
+  ro_group_weight = 50
+  ro_group_offline_delay = 1
+  ro_group_max_delay_time = 10
+  ro_group_min_instances = 1
   tags = {
     name ="test"
   }
@@ -42,6 +49,74 @@ func resourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
 			Required:    true,
 			Description: "Indicates the master instance ID of recovery instances.",
 		},
+		"ro_group_mode": {
+			Type:         schema.TypeString,
+			ForceNew:     true,
+			Optional:     true,
+			Default:      "allinone",
+			ValidateFunc: validateAllowedStringValue(MYSQL_RO_GROUP_MODES),
+			// the mode is not returned by the API, so instances created before this
+			// field existed or imported ones have no value in state and must not be rebuilt
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return old == "" && d.Id() != ""
+			},
+			Description: "Mode of the read-only group. Available values: alone - assigned by system; allinone - create a new read-only group; join - join an existing read-only group specified by ro_group_id. Default value is allinone.",
+		},
+		"ro_group_id": {
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Optional:    true,
+			Computed:    true,
+			Description: "ID of the read-only group to join, required when ro_group_mode is join.",
+		},
+		"ro_group_name": {
+			Type:         schema.TypeString,
+			ForceNew:     true,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateStringLengthInRange(1, 60),
+			Description:  "Name of the read-only group to create.",
+		},
+		"ro_group_weight_mode": {
+			Type:         schema.TypeString,
+			ForceNew:     true,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateAllowedStringValue(MYSQL_RO_GROUP_WEIGHT_MODES),
+			Description:  "Read weight allocation mode of the read-only group. Available values: system - assigned by system; custom - use ro_group_weight. If not set, system is used when creating a new read-only group.",
+		},
+		"ro_group_weight": {
+			Type:         schema.TypeInt,
+			ForceNew:     true,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateIntegerInRange(0, 100),
+			Description:  "Read weight of this instance in the read-only group, only takes effect when ro_group_weight_mode is custom.",
+		},
+		"ro_group_offline_delay": {
+			Type:         schema.TypeInt,
+			ForceNew:     true,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateAllowedIntValue([]int{0, 1}),
+			Description:  "Indicates whether to remove the instance from the read-only group when its replication delay exceeds ro_group_max_delay_time: 0 - No, 1 - Yes.",
+		},
+		"ro_group_max_delay_time": {
+			Type:         schema.TypeInt,
+			ForceNew:     true,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateIntegerMin(1),
+			Description:  "Replication delay threshold (in seconds) used for delay-based removal.",
+		},
+		"ro_group_min_instances": {
+			Type:         schema.TypeInt,
+			ForceNew:     true,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateIntegerMin(1),
+			Description:  "Minimum number of instances kept in the read-only group when delay-based removal takes effect.",
+		},
 	}
 
 	basic := TencentMsyqlBasicInfo()
@@ -59,6 +134,55 @@ func resourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
 	}
 }
 
+func mysqlReadonlyInstanceRoGroup(d *schema.ResourceData) (roGroup *cdb.RoGroup, errRet error) {
+	roGroupMode := d.Get("ro_group_mode").(string)
+	roGroup = &cdb.RoGroup{RoGroupMode: &roGroupMode}
+
+	if roGroupMode == "join" {
+		roGroupId, ok := d.GetOk("ro_group_id")
+		if !ok {
+			errRet = fmt.Errorf("ro_group_id is required when ro_group_mode is join")
+			return
+		}
+		roGroup.RoGroupId = stringToPointer(roGroupId.(string))
+		return
+	}
+
+	if v, ok := d.GetOk("ro_group_name"); ok {
+		roGroup.RoGroupName = stringToPointer(v.(string))
+	}
+
+	weightMode := "system"
+	if v, ok := d.GetOk("ro_group_weight_mode"); ok {
+		weightMode = v.(string)
+	}
+	roGroup.WeightMode = &weightMode
+	if v, ok := d.GetOk("ro_group_weight"); ok {
+		if weightMode != "custom" {
+			errRet = fmt.Errorf("ro_group_weight only takes effect when ro_group_weight_mode is custom")
+			return
+		}
+		weight := int64(v.(int))
+		roGroup.Weight = &weight
+	}
+
+	offlineDelay := int64(d.Get("ro_group_offline_delay").(int))
+	roGroup.RoOfflineDelay = &offlineDelay
+	if v, ok := d.GetOk("ro_group_max_delay_time"); ok {
+		maxDelayTime := int64(v.(int))
+		roGroup.RoMaxDelayTime = &maxDelayTime
+	}
+	if v, ok := d.GetOk("ro_group_min_instances"); ok {
+		minInstances := int64(v.(int))
+		roGroup.MinRoInGroup = &minInstances
+	}
+	if offlineDelay == 1 && roGroup.RoMaxDelayTime == nil {
+		errRet = fmt.Errorf("ro_group_max_delay_time is required when ro_group_offline_delay is 1")
+		return
+	}
+	return
+}
+
 func mysqlCreateReadonlyInstancePayByMonth(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	logId := GetLogId(ctx)
 
@@ -75,9 +199,11 @@ func mysqlCreateReadonlyInstancePayByMonth(ctx context.Context, d *schema.Resour
 	masterInstanceId := d.Get("master_instance_id").(string)
 	request.MasterInstanceId = &masterInstanceId
 
-	// readonly group is not currently supported
-	defaultRoGroupMode := "allinone"
-	request.RoGroup = &cdb.RoGroup{RoGroupMode: &defaultRoGroupMode}
+	roGroup, err := mysqlReadonlyInstanceRoGroup(d)
+	if err != nil {
+		return err
+	}
+	request.RoGroup = roGroup
 
 	if err := mysqlAllInstanceRoleSet(ctx, request, d, meta); err != nil {
 		return err
@@ -109,9 +235,11 @@ func mysqlCreateReadonlyInstancePayByUse(ctx context.Context, d *schema.Resource
 	masterInstanceId := d.Get("master_instance_id").(string)
 	request.MasterInstanceId = &masterInstanceId
 
-	// readonly group is not currently supported
-	defaultRoGroupMode := "allinone"
-	request.RoGroup = &cdb.RoGroup{RoGroupMode: &defaultRoGroupMode}
+	roGroup, err := mysqlReadonlyInstanceRoGroup(d)
+	if err != nil {
+		return err
+	}
+	request.RoGroup = roGroup
 
 	if err := mysqlAllInstanceRoleSet(ctx, request, d, meta); err != nil {
 		return err
@@ -212,7 +340,44 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	d.Set("master_instance_id", *mysqlInfo.MasterInfo.InstanceId)
+	if mysqlInfo == nil {
+		d.SetId("")
+		return nil
+	}
+	if mysqlInfo.MasterInfo == nil || mysqlInfo.MasterInfo.InstanceId == nil {
+		return fmt.Errorf("mysql readonly instance %s has no master instance info", d.Id())
+	}
+	masterInstanceId := *mysqlInfo.MasterInfo.InstanceId
+	d.Set("master_instance_id", masterInstanceId)
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+	roGroup, roInstance, err := mysqlService.DescribeRoGroupOfInstance(ctx, masterInstanceId, d.Id())
+	if err != nil {
+		return err
+	}
+	if roGroup != nil {
+		if roGroup.RoGroupId != nil {
+			d.Set("ro_group_id", *roGroup.RoGroupId)
+		}
+		if roGroup.RoGroupName != nil {
+			d.Set("ro_group_name", *roGroup.RoGroupName)
+		}
+		if roGroup.WeightMode != nil {
+			d.Set("ro_group_weight_mode", *roGroup.WeightMode)
+		}
+		if roGroup.RoOfflineDelay != nil {
+			d.Set("ro_group_offline_delay", int(*roGroup.RoOfflineDelay))
+		}
+		if roGroup.RoMaxDelayTime != nil {
+			d.Set("ro_group_max_delay_time", int(*roGroup.RoMaxDelayTime))
+		}
+		if roGroup.MinRoInGroup != nil {
+			d.Set("ro_group_min_instances", int(*roGroup.MinRoInGroup))
+		}
+	}
+	if roInstance != nil && roInstance.Weight != nil {
+		d.Set("ro_group_weight", int(*roInstance.Weight))
+	}
 
 	return nil
 }
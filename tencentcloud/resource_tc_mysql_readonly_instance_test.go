@@ -77,6 +77,29 @@ func TestAccTencentCloudMysqlReadonlyInstance(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudMysqlReadonlyInstance_roGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMysqlReadonlyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlReadonlyInstance_roGroup(MysqlInstanceCommonTestCase),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMysqlInstanceExists("tencentcloud_mysql_readonly_instance.mysql_readonly"),
+					resource.TestCheckResourceAttrSet("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_id"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_name", "tf-test-ro-group"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_weight_mode", "custom"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_weight", "50"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_offline_delay", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_max_delay_time", "10"),
+					resource.TestCheckResourceAttr("tencentcloud_mysql_readonly_instance.mysql_readonly", "ro_group_min_instances", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMysqlReadonlyInstanceDestroy(s *terraform.State) error {
 	logId := GetLogId(nil)
 	ctx := context.WithValue(context.TODO(), "logId", logId)
@@ -174,3 +197,22 @@ resource "tencentcloud_mysql_readonly_instance" "mysql_readonly" {
 }
 	`, mysqlTestCase, instance_name, instranet_port)
 }
+
+func testAccMysqlReadonlyInstance_roGroup(mysqlTestCase string) string {
+	return fmt.Sprintf(`
+%s
+resource "tencentcloud_mysql_readonly_instance" "mysql_readonly" {
+	master_instance_id = "${tencentcloud_mysql_instance.default.id}"
+	mem_size = 1000
+	volume_size = 50
+	instance_name = "mysql-readonly-test"
+	ro_group_mode = "allinone"
+	ro_group_name = "tf-test-ro-group"
+	ro_group_weight_mode = "custom"
+	ro_group_weight = 50
+	ro_group_offline_delay = 1
+	ro_group_max_delay_time = 10
+	ro_group_min_instances = 1
+}
+	`, mysqlTestCase)
+}
@@ -0,0 +1,184 @@
+/*
+Provides a resource to create a manual backup of a Redis instance.
+
+~> **NOTE:** Redis backups can not be deleted by API, destroying this resource only removes it from the state, and the backup is kept until it expires.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_redis_backup" "redislab" {
+  redis_id = "crs-7yl0q0dd"
+  remark   = "before upgrade"
+}
+```
+
+Import
+
+Redis backup can be imported, e.g.
+
+```hcl
+$ terraform import tencentcloud_redis_backup.redislab crs-7yl0q0dd#641186639-8362913-1516672770
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTencentCloudRedisBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudRedisBackupCreate,
+		Read:   resourceTencentCloudRedisBackupRead,
+		Delete: resourceTencentCloudRedisBackupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+				Description: "ID of the Redis instance to be backed up.",
+			},
+			"remark": {
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Optional:    true,
+				Description: "Remark of the backup.",
+			},
+
+			// Computed values
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the backup.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the backup, maybe: manualBackupInstance and systemBackupInstance.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the backup, maybe: locked, normal, exporting, exported and expired.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the backup is locked.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup was started.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudRedisBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_redis_backup.create")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	redisId := d.Get("redis_id").(string)
+	remark := d.Get("remark").(string)
+
+	//ManualBackupInstance only returns a task id, so the new backup is found out by comparing the backup lists
+	oldBackups, err := service.DescribeInstanceBackups(ctx, redisId, "", "")
+	if err != nil {
+		return err
+	}
+	oldBackupIds := make(map[string]bool, len(oldBackups))
+	for _, backup := range oldBackups {
+		oldBackupIds[*backup.BackupId] = true
+	}
+
+	taskId, err := service.ManualBackupInstance(ctx, redisId, remark)
+	if err != nil {
+		return err
+	}
+	err = service.WaitForTaskFinish(ctx, redisId, taskId, 20*time.Minute)
+	if err != nil {
+		log.Printf("[CRITAL]%s redis manual backup fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	newBackups, err := service.DescribeInstanceBackups(ctx, redisId, "", "")
+	if err != nil {
+		return err
+	}
+	backupId := ""
+	for _, backup := range newBackups {
+		if oldBackupIds[*backup.BackupId] || *backup.BackupType != REDIS_BACKUP_TYPE_MANUAL {
+			continue
+		}
+		backupId = *backup.BackupId
+		break
+	}
+	if backupId == "" {
+		return fmt.Errorf("redis manual backup task %d finished, but the backup is not found", taskId)
+	}
+
+	d.SetId(redisId + FILED_SP + backupId)
+	return resourceTencentCloudRedisBackupRead(d, meta)
+}
+
+func resourceTencentCloudRedisBackupRead(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_redis_backup.read")()
+
+	logId := GetLogId(nil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	items := strings.Split(d.Id(), FILED_SP)
+	if len(items) != 2 {
+		return fmt.Errorf("id of resource.tencentcloud_redis_backup is wrong")
+	}
+	redisId, backupId := items[0], items[1]
+
+	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+	backup, err := service.DescribeInstanceBackupById(ctx, redisId, backupId)
+	if err != nil {
+		return err
+	}
+	if backup == nil {
+		d.SetId("")
+		return nil
+	}
+
+	status := REDIS_BACKUP_STATUS[*backup.Status]
+	if status == "" {
+		status = "unknown"
+	}
+
+	d.Set("redis_id", redisId)
+	d.Set("backup_id", backupId)
+	d.Set("remark", pointerToString(backup.Remark))
+	d.Set("backup_type", *backup.BackupType)
+	d.Set("status", status)
+	d.Set("locked", *backup.Locked == 1)
+	d.Set("start_time", *backup.StartTime)
+
+	return nil
+}
+
+func resourceTencentCloudRedisBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	defer LogElapsed("source.tencentcloud_redis_backup.delete")()
+
+	//backups of redis can not be deleted, they are removed after expired
+	d.SetId("")
+	return nil
+}
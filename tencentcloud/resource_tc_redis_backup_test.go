@@ -0,0 +1,80 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTencentCloudRedisBackup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTencentCloudRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisBackup(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisBackupExists("tencentcloud_redis_backup.redis_backup"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup.redis_backup", "redis_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup.redis_backup", "backup_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_redis_backup.redis_backup", "start_time"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_backup.redis_backup", "remark", "terraform_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_backup.redis_backup", "backup_type", "manualBackupInstance"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_redis_backup.redis_backup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTencentCloudRedisBackupExists(r string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := GetLogId(nil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("resource %s is not found", r)
+		}
+		items := strings.Split(rs.Primary.ID, FILED_SP)
+		if len(items) != 2 {
+			return fmt.Errorf("redis backup id %s is wrong", rs.Primary.ID)
+		}
+
+		service := RedisService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+		backup, err := service.DescribeInstanceBackupById(ctx, items[0], items[1])
+		if err != nil {
+			return err
+		}
+		if backup == nil {
+			return fmt.Errorf("redis backup %s not exists.", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccRedisBackup() string {
+	return `
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone="ap-guangzhou-3"
+	type="master_slave_redis"
+	password="test12345789"
+	mem_size=8192
+	name="terrform_test"
+	port=6379
+}
+
+resource "tencentcloud_redis_backup" "redis_backup" {
+	redis_id = "${tencentcloud_redis_instance.redis_instance_test.id}"
+	remark   = "terraform_test"
+}`
+}
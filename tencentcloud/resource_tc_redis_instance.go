@@ -11,6 +11,43 @@ resource "tencentcloud_redis_instance" "redis_instance_test"{
 	mem_size=8192
 	name="terrform_test"
 	port=6379
+	params = {
+		maxmemory-policy = "allkeys-lru"
+		timeout          = "300"
+	}
+}
+```
+
+Using cluster edition with prepaid charge type
+
+```hcl
+resource "tencentcloud_redis_instance" "redis_cluster" {
+  availability_zone  = "ap-guangzhou-3"
+  type               = "cluster_redis"
+  password           = "test12345789"
+  mem_size           = 4096
+  redis_shard_num    = 3
+  redis_replicas_num = 1
+  name               = "terrform_cluster"
+  charge_type        = "PREPAID"
+  prepaid_period     = 1
+  auto_renew_flag    = 1
+}
+```
+
+Using vpc network with a specified ip and replica readonly
+
+```hcl
+resource "tencentcloud_redis_instance" "redis_vpc" {
+  availability_zone = "ap-guangzhou-3"
+  type              = "master_slave_redis"
+  password          = "test12345789"
+  mem_size          = 8192
+  name              = "terrform_vpc"
+  vpc_id            = "vpc-xxxxxx"
+  subnet_id         = "subnet-xxxxxx"
+  ip                = "10.0.0.10"
+  replica_readonly  = true
 }
 ```
 
@@ -76,7 +113,41 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 					errors = append(errors, fmt.Errorf("this redis type %s not support now.", value))
 					return
 				},
-				Description: "Instance type. Available values: master_slave_redis.",
+				Description: "Instance type. Available values: master_slave_redis, master_slave_ckv, cluster_redis, cluster_ckv and standalone_redis.",
+			},
+			"redis_shard_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of shards of a cluster edition instance, which can only be set when type is cluster_redis or cluster_ckv.",
+			},
+			"redis_replicas_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of replicas of each shard of a cluster edition instance, which can only be set when type is cluster_redis or cluster_ckv.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Default:      REDIS_CHARGE_TYPE_POSTPAID,
+				ValidateFunc: validateAllowedStringValue([]string{REDIS_CHARGE_TYPE_POSTPAID, REDIS_CHARGE_TYPE_PREPAID}),
+				Description:  "The charge type of instance. Available values: POSTPAID and PREPAID, and default is POSTPAID.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue(REDIS_AVAILABLE_PERIOD),
+				Description:  "The tenancy (in month) of a prepaid instance, and value range [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36]. It can only increase, and the instance is renewed for the increased months, except for the first value set after importing. It is ignored when charge_type is POSTPAID.",
+			},
+			"auto_renew_flag": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateAllowedIntValue([]int{0, 1, 2}),
+				Description:  "Auto renew flag of a prepaid instance. 0 - Manual renewal by default; 1 - Automatic renewal; 2 - Explicitly no automatic renewal. It is ignored when charge_type is POSTPAID.",
 			},
 			"password": {
 				Type:         schema.TypeString,
@@ -88,20 +159,18 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 			"mem_size": {
 				Type:        schema.TypeInt,
 				Required:    true,
-				Description: "The memory volume of an available instance(in MB), refer to tencentcloud_redis_zone_config.list[zone].mem_sizes",
+				Description: "The memory volume of an available instance(in MB), refer to tencentcloud_redis_zone_config.list[zone].mem_sizes. For cluster editions, it is the memory volume of each shard.",
 			},
 			"vpc_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validateStringLengthInRange(1, 100),
-				Description:  "ID of the vpc with which the instance is to be associated.",
+				Description:  "ID of the vpc with which the instance is to be associated. Changing it moves the instance to the new vpc, and `subnet_id` must be changed too.",
 			},
 			"subnet_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validateStringLengthInRange(1, 100),
 				Description:  "Specifies which subnet the instance should belong to.",
@@ -129,12 +198,35 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 				Default:     6379,
 				Description: "The port used to access a redis instance. The default value is 6379. And this value can't be changed after creation, or the Redis instance will be recreated.",
 			},
+			"params": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Parameters of the instance, such as maxmemory-policy and timeout. Only the parameters set here are managed, and a removed parameter is restored to its default value.",
+			},
+			"restore_from_backup_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of a backup of this instance to restore data from. The data is restored when the instance is created or this value is changed, and removing it does nothing.",
+			},
 
 			// Computed values
 			"ip": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIp,
+				Description:  "IP address of an instance. It can be set to an unused ip of the subnet to change the vip of the instance.",
+			},
+			"replica_readonly": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to enable replica readonly, read requests are routed to replicas when enabled. Default is false.",
+			},
+			"replica_read_weight": {
+				Type:        schema.TypeInt,
 				Computed:    true,
-				Description: "IP address of an instance.",
+				Description: "Read weight of the replicas, 0 means reading from the master only, 100 means the read requests are routed to the replicas.",
 			},
 			"status": {
 				Type:        schema.TypeString,
@@ -169,6 +261,13 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 	securityGroups := d.Get("security_groups").(*schema.Set).List()
 	projectId := d.Get("project_id").(int)
 	port := d.Get("port").(int)
+	chargeType := d.Get("charge_type").(string)
+	shardNum := d.Get("redis_shard_num").(int)
+	replicasNum := d.Get("redis_replicas_num").(int)
+
+	if !redisIsClusterType(redisType) && (shardNum > 0 || replicasNum > 0) {
+		return fmt.Errorf("redis_shard_num and redis_replicas_num can only be set when type is one of %v", REDIS_CLUSTER_NAMES)
+	}
 
 	if availabilityZone != "" {
 		if !strings.Contains(availabilityZone, region) {
@@ -192,7 +291,12 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 		int64(memSize),
 		int64(projectId),
 		int64(port),
-		requestSecurityGroup)
+		requestSecurityGroup,
+		chargeType,
+		int64(d.Get("prepaid_period").(int)),
+		int64(d.Get("auto_renew_flag").(int)),
+		int64(shardNum),
+		int64(replicasNum))
 
 	if err != nil {
 		return err
@@ -202,8 +306,28 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("redis api CreateInstances return  empty redis id")
 	}
 	var redisId = dealId
+
+	//a prepaid instance is delivered by a deal
+	if chargeType == REDIS_CHARGE_TYPE_PREPAID {
+		err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+			done, id, err := service.DescribeInstanceDealDetail(ctx, dealId)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if !done {
+				return resource.RetryableError(fmt.Errorf("redis deal %s is processing", dealId))
+			}
+			redisId = id
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s create redis deal fail, reason:%s\n ", logId, err.Error())
+			return err
+		}
+	}
+
 	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
-		has, online, _, err := service.CheckRedisCreateOk(ctx, dealId)
+		has, online, _, err := service.CheckRedisCreateOk(ctx, redisId)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -221,6 +345,40 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 	d.SetId(redisId)
+
+	if backupId, ok := d.GetOk("restore_from_backup_id"); ok {
+		err = redisInstanceRestore(ctx, &service, d.Id(), password, backupId.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	if params, ok := d.GetOk("params"); ok {
+		err = redisInstanceModifyParams(ctx, &service, d.Id(), map[string]interface{}{}, params.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	//vip can not be specified by CreateInstances, change it after the instance is online
+	if ip, ok := d.GetOk("ip"); ok {
+		_, _, info, err := service.CheckRedisCreateOk(ctx, d.Id())
+		if err != nil {
+			return err
+		}
+		if info != nil && *info.WanIp != ip.(string) {
+			err = redisInstanceModifyNetwork(ctx, &service, d.Id(), REDIS_NETWORK_CHANGE_VIP, ip.(string), "", "")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Get("replica_readonly").(bool) {
+		if err = service.EnableReplicaReadonly(ctx, d.Id()); err != nil {
+			return err
+		}
+	}
 	return resourceTencentCloudRedisInstanceRead(d, meta)
 }
 
@@ -272,7 +430,23 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("type", typeName)
 
-	d.Set("mem_size", int64(*info.Size))
+	if redisIsClusterType(typeName) && info.RedisShardSize != nil && *info.RedisShardSize > 0 {
+		d.Set("mem_size", *info.RedisShardSize)
+	} else {
+		d.Set("mem_size", int64(*info.Size))
+	}
+	if info.RedisShardNum != nil {
+		d.Set("redis_shard_num", *info.RedisShardNum)
+	}
+	if info.RedisReplicasNum != nil {
+		d.Set("redis_replicas_num", *info.RedisReplicasNum)
+	}
+	if info.BillingMode != nil {
+		d.Set("charge_type", REDIS_CHARGE_TYPE_NAME[*info.BillingMode])
+	}
+	if info.AutoRenewFlag != nil {
+		d.Set("auto_renew_flag", *info.AutoRenewFlag)
+	}
 
 	d.Set("vpc_id", *info.UniqVpcId)
 	d.Set("subnet_id", *info.UniqSubnetId)
@@ -282,6 +456,11 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	d.Set("ip", *info.WanIp)
 	d.Set("create_time", *info.Createtime)
 
+	if info.SlaveReadWeight != nil {
+		d.Set("replica_read_weight", *info.SlaveReadWeight)
+		d.Set("replica_readonly", *info.SlaveReadWeight > 0)
+	}
+
 	if d.Get("vpc_id").(string) != "" {
 		securityGroups, err := service.DescribeInstanceSecurityGroup(ctx, d.Id())
 		if err != nil {
@@ -291,6 +470,23 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 			d.Set("security_groups", securityGroups)
 		}
 	}
+
+	params, ok := d.Get("params").(map[string]interface{})
+	if ok && len(params) > 0 {
+		currentParams, _, err := service.DescribeInstanceParams(ctx, d.Id())
+		if err != nil {
+			return err
+		}
+		caresParams := make(map[string]interface{}, len(params))
+		for k := range params {
+			if v, has := currentParams[k]; has {
+				caresParams[k] = v
+			}
+		}
+		if err := d.Set("params", caresParams); err != nil {
+			log.Printf("[CRITAL]%s provider set redis params fail, reason:%s\n ", logId, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -319,27 +515,37 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		d.SetPartial("name")
 	}
 
-	if d.HasChange("mem_size") {
+	if d.HasChange("mem_size") || d.HasChange("redis_shard_num") || d.HasChange("redis_replicas_num") {
 
 		oldInter, newInter := d.GetChange("mem_size")
 		newMemSize := newInter.(int)
 		oldMemSize := oldInter.(int)
 
-		if oldMemSize >= newMemSize {
+		if d.HasChange("mem_size") && oldMemSize >= newMemSize {
 			return fmt.Errorf("redis mem_size can only increase")
 		}
 
 		if newMemSize < 1 {
 			return fmt.Errorf("redis mem_size value cannot be set to less than 1")
 		}
-		redisId, err := service.UpgradeInstance(ctx, d.Id(), int64(newMemSize))
+
+		var newShardNum, newReplicasNum int64
+		if redisIsClusterType(d.Get("type").(string)) {
+			newShardNum = int64(d.Get("redis_shard_num").(int))
+			newReplicasNum = int64(d.Get("redis_replicas_num").(int))
+		} else if d.HasChange("redis_shard_num") || d.HasChange("redis_replicas_num") {
+			return fmt.Errorf("redis_shard_num and redis_replicas_num can only be set when type is one of %v", REDIS_CLUSTER_NAMES)
+		}
+
+		_, err := service.UpgradeInstance(ctx, d.Id(), int64(newMemSize), newShardNum, newReplicasNum)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s  redis update mem size error, reason:%s\n ", logId, err.Error())
+			return err
 		}
 
 		err = resource.Retry(600*time.Second, func() *resource.RetryError {
-			_, _, info, err := service.CheckRedisCreateOk(ctx, redisId)
+			_, _, info, err := service.CheckRedisCreateOk(ctx, d.Id())
 
 			if info != nil {
 				status := REDIS_STATUS[*info.Status]
@@ -371,6 +577,48 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 
 		d.SetPartial("mem_size")
+		d.SetPartial("redis_shard_num")
+		d.SetPartial("redis_replicas_num")
+	}
+
+	// prepaid_period is not returned by the api, so an imported instance has none in state,
+	// and the configured value is only recorded instead of renewing the instance for it
+	if d.HasChange("prepaid_period") && d.Get("charge_type").(string) == REDIS_CHARGE_TYPE_PREPAID {
+		old, new := d.GetChange("prepaid_period")
+		oldValue := old.(int)
+		newValue := new.(int)
+		if oldValue > 0 {
+			if oldValue > newValue {
+				return fmt.Errorf("prepaid_period of redis instance can only increase")
+			}
+			validPeriod := false
+			for _, period := range REDIS_AVAILABLE_PERIOD {
+				if period == newValue-oldValue {
+					validPeriod = true
+					break
+				}
+			}
+			if !validPeriod {
+				return fmt.Errorf("the increase of prepaid_period of redis instance must be one of %v, got %d", REDIS_AVAILABLE_PERIOD, newValue-oldValue)
+			}
+			_, err := service.RenewInstance(ctx, d.Id(), int64(newValue-oldValue))
+			if err != nil {
+				log.Printf("[CRITAL]%s  redis renew error, reason:%s\n ", logId, err.Error())
+				return err
+			}
+		}
+		d.SetPartial("prepaid_period")
+	}
+
+	if d.HasChange("auto_renew_flag") {
+		if d.Get("charge_type").(string) == REDIS_CHARGE_TYPE_PREPAID {
+			err := service.ModifyAutoRenewFlag(ctx, d.Id(), int64(d.Get("auto_renew_flag").(int)))
+			if err != nil {
+				log.Printf("[CRITAL]%s  redis modify auto renew flag error, reason:%s\n ", logId, err.Error())
+				return err
+			}
+		}
+		d.SetPartial("auto_renew_flag")
 	}
 
 	if d.HasChange("password") {
@@ -411,6 +659,191 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 		d.SetPartial("project_id")
 	}
+
+	if d.HasChange("restore_from_backup_id") {
+		backupId := d.Get("restore_from_backup_id").(string)
+		if backupId != "" {
+			err := redisInstanceRestore(ctx, &service, d.Id(), d.Get("password").(string), backupId)
+			if err != nil {
+				return err
+			}
+		}
+		d.SetPartial("restore_from_backup_id")
+	}
+
+	if d.HasChange("params") {
+		oldParams, newParams := d.GetChange("params")
+		err := redisInstanceModifyParams(ctx, &service, d.Id(), oldParams.(map[string]interface{}), newParams.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		d.SetPartial("params")
+	}
+
+	if d.HasChange("vpc_id") || d.HasChange("subnet_id") {
+		oldVpcId, _ := d.GetChange("vpc_id")
+		operation := REDIS_NETWORK_CHANGE_VPC
+		if oldVpcId.(string) == "" {
+			operation = REDIS_NETWORK_CHANGE_BASE_TO_VPC
+		}
+		vpcId := d.Get("vpc_id").(string)
+		subnetId := d.Get("subnet_id").(string)
+		if vpcId == "" || subnetId == "" {
+			return fmt.Errorf("redis vpc_id and subnet_id must be set together")
+		}
+		ip := ""
+		if d.HasChange("ip") {
+			ip = d.Get("ip").(string)
+		}
+		err := redisInstanceModifyNetwork(ctx, &service, d.Id(), operation, ip, vpcId, subnetId)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("vpc_id")
+		d.SetPartial("subnet_id")
+		d.SetPartial("ip")
+	} else if d.HasChange("ip") {
+		if ip := d.Get("ip").(string); ip != "" {
+			err := redisInstanceModifyNetwork(ctx, &service, d.Id(), REDIS_NETWORK_CHANGE_VIP, ip, "", "")
+			if err != nil {
+				return err
+			}
+		}
+		d.SetPartial("ip")
+	}
+
+	if d.HasChange("replica_readonly") {
+		var err error
+		if d.Get("replica_readonly").(bool) {
+			err = service.EnableReplicaReadonly(ctx, d.Id())
+		} else {
+			err = service.DisableReplicaReadonly(ctx, d.Id())
+		}
+		if err != nil {
+			return err
+		}
+		d.SetPartial("replica_readonly")
+	}
+	d.Partial(false)
+
+	return resourceTencentCloudRedisInstanceRead(d, meta)
+}
+
+func redisInstanceRestore(ctx context.Context, service *RedisService, redisId, password, backupId string) error {
+	logId := GetLogId(ctx)
+
+	taskId, err := service.RestoreInstance(ctx, redisId, password, backupId)
+	if err != nil {
+		return err
+	}
+	err = service.WaitForTaskFinish(ctx, redisId, taskId, 60*time.Minute)
+	if err != nil {
+		log.Printf("[CRITAL]%s redis restore from backup %s fail, reason:%s\n ", logId, backupId, err.Error())
+		return err
+	}
+	return nil
+}
+
+//ModifyNetworkConfig returns no task id, so wait until the instance is online with the expected network
+func redisInstanceModifyNetwork(ctx context.Context, service *RedisService, redisId, operation, vip,
+	vpcId, subnetId string) error {
+
+	logId := GetLogId(ctx)
+
+	err := service.ModifyNetworkConfig(ctx, redisId, operation, vip, vpcId, subnetId)
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		has, online, info, err := service.CheckRedisCreateOk(ctx, redisId)
+		if err != nil {
+			if _, ok := err.(*errors.TencentCloudSDKError); !ok {
+				return resource.RetryableError(err)
+			} else {
+				return resource.NonRetryableError(err)
+			}
+		}
+		if !has {
+			return resource.NonRetryableError(fmt.Errorf("after modify redis network, redis disappear"))
+		}
+		if !online {
+			return resource.RetryableError(fmt.Errorf("redis modify network is processing"))
+		}
+		if (vip != "" && *info.WanIp != vip) ||
+			(vpcId != "" && *info.UniqVpcId != vpcId) ||
+			(subnetId != "" && *info.UniqSubnetId != subnetId) {
+			return resource.RetryableError(fmt.Errorf("redis modify network is processing"))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s redis modify network %s fail, reason:%s\n ", logId, operation, err.Error())
+		return err
+	}
+	return nil
+}
+
+func redisInstanceModifyParams(ctx context.Context, service *RedisService, redisId string,
+	oldParams, newParams map[string]interface{}) error {
+
+	logId := GetLogId(ctx)
+
+	currentParams, defaultParams, err := service.DescribeInstanceParams(ctx, redisId)
+	if err != nil {
+		return err
+	}
+
+	for name := range newParams {
+		if _, has := currentParams[name]; !has {
+			return fmt.Errorf("this redis not support param %s set", name)
+		}
+	}
+
+	//params removed from config are restored to default, others are added or modified
+	modifyParams := make(map[string]string)
+	for name := range oldParams {
+		if _, has := newParams[name]; !has {
+			if defaultValue, has := defaultParams[name]; has && currentParams[name] != defaultValue {
+				modifyParams[name] = defaultValue
+			}
+		}
+	}
+	for name, value := range newParams {
+		if currentParams[name] != value.(string) {
+			modifyParams[name] = value.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] %s redis need set params:%+v\n", logId, modifyParams)
+	if len(modifyParams) == 0 {
+		return nil
+	}
+
+	if err := service.ModifyInstanceParams(ctx, redisId, modifyParams); err != nil {
+		return err
+	}
+
+	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		currentParams, _, err := service.DescribeInstanceParams(ctx, redisId)
+		if err != nil {
+			if _, ok := err.(*errors.TencentCloudSDKError); !ok {
+				return resource.RetryableError(err)
+			} else {
+				return resource.NonRetryableError(err)
+			}
+		}
+		for name, value := range modifyParams {
+			if currentParams[name] != value {
+				return resource.RetryableError(fmt.Errorf("redis param %s is modifying", name))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s redis modify params fail, reason:%s\n ", logId, err.Error())
+		return err
+	}
 	return nil
 }
 
@@ -423,7 +856,20 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
+	if d.Get("charge_type").(string) == REDIS_CHARGE_TYPE_PREPAID {
+		_, err := service.DestroyPrepaidInstance(ctx, d.Id())
+		return err
+	}
 	_, err := service.DestroyPostpaidInstance(ctx, d.Id())
 
 	return err
 }
+
+func redisIsClusterType(redisType string) bool {
+	for _, name := range REDIS_CLUSTER_NAMES {
+		if name == redisType {
+			return true
+		}
+	}
+	return false
+}
@@ -60,6 +60,23 @@ func TestAccTencentCloudRedisInstance(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "status", "online"),
 				),
 			},
+			{
+				Config: testAccRedisInstanceUpdateParams(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "params.%", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "params.maxmemory-policy", "allkeys-lru"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "params.timeout", "300"),
+				),
+			},
+			{
+				Config: testAccRedisInstanceUpdateReplicaReadonly(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "replica_readonly", "true"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "replica_read_weight", "100"),
+				),
+			},
 		},
 	})
 }
@@ -146,3 +163,190 @@ resource "tencentcloud_redis_instance" "redis_instance_test"{
 	port=6379
 }`
 }
+
+func testAccRedisInstanceUpdateParams() string {
+	return `
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone="ap-guangzhou-3"
+	type="master_slave_redis"
+	password="AAA123456BBB"
+	mem_size=12288
+	name="terrform_test_update"
+	port=6379
+	params = {
+		maxmemory-policy = "allkeys-lru"
+		timeout          = "300"
+	}
+}`
+}
+
+func testAccRedisInstanceUpdateReplicaReadonly() string {
+	return `
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone="ap-guangzhou-3"
+	type="master_slave_redis"
+	password="AAA123456BBB"
+	mem_size=12288
+	name="terrform_test_update"
+	port=6379
+	replica_readonly=true
+	params = {
+		maxmemory-policy = "allkeys-lru"
+		timeout          = "300"
+	}
+}`
+}
+
+func TestAccTencentCloudRedisInstance_network(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTencentCloudRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstanceNetwork("subnet", "10.2.11.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttrPair("tencentcloud_redis_instance.redis_instance_test", "subnet_id", "tencentcloud_subnet.subnet", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "ip", "10.2.11.10"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "status", "online"),
+				),
+			},
+			{
+				Config: testAccRedisInstanceNetwork("subnet", "10.2.11.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "ip", "10.2.11.20"),
+				),
+			},
+			{
+				Config: testAccRedisInstanceNetwork("subnet_another", "10.2.12.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttrPair("tencentcloud_redis_instance.redis_instance_test", "subnet_id", "tencentcloud_subnet.subnet_another", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "ip", "10.2.12.10"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "status", "online"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedisInstanceNetwork(subnet, ip string) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_vpc" "vpc" {
+	name       = "tf-redis-vpc"
+	cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+	vpc_id            = "${tencentcloud_vpc.vpc.id}"
+	name              = "tf-redis-subnet"
+	cidr_block        = "10.2.11.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_subnet" "subnet_another" {
+	vpc_id            = "${tencentcloud_vpc.vpc.id}"
+	name              = "tf-redis-subnet-another"
+	cidr_block        = "10.2.12.0/24"
+	availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone = "ap-guangzhou-3"
+	type              = "master_slave_redis"
+	password          = "test12345789"
+	mem_size          = 8192
+	name              = "terrform_test_network"
+	port              = 6379
+	vpc_id            = "${tencentcloud_vpc.vpc.id}"
+	subnet_id         = "${tencentcloud_subnet.%s.id}"
+	ip                = "%s"
+}`, subnet, ip)
+}
+
+func TestAccTencentCloudRedisInstance_cluster(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTencentCloudRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstanceCluster(3, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "type", "cluster_redis"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "mem_size", "4096"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "redis_shard_num", "3"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "redis_replicas_num", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "charge_type", "POSTPAID"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "status", "online"),
+				),
+			},
+			{
+				Config: testAccRedisInstanceCluster(5, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "redis_shard_num", "5"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "redis_replicas_num", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "status", "online"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudRedisInstance_prepaid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTencentCloudRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstancePrepaid(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "charge_type", "PREPAID"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "prepaid_period", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "status", "online"),
+				),
+			},
+			{
+				Config: testAccRedisInstancePrepaid(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTencentCloudRedisInstanceExists("tencentcloud_redis_instance.redis_instance_test"),
+					resource.TestCheckResourceAttr("tencentcloud_redis_instance.redis_instance_test", "prepaid_period", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedisInstanceCluster(shardNum, replicasNum int) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone  = "ap-guangzhou-3"
+	type               = "cluster_redis"
+	password           = "test12345789"
+	mem_size           = 4096
+	redis_shard_num    = %d
+	redis_replicas_num = %d
+	name               = "terrform_test_cluster"
+	port               = 6379
+}`, shardNum, replicasNum)
+}
+
+func testAccRedisInstancePrepaid(period int) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_redis_instance" "redis_instance_test"{
+	availability_zone = "ap-guangzhou-3"
+	type              = "master_slave_redis"
+	password          = "test12345789"
+	mem_size          = 8192
+	name              = "terrform_test_prepaid"
+	port              = 6379
+	charge_type       = "PREPAID"
+	prepaid_period    = %d
+}`, period)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/zqfan/tencentcloud-sdk-go/common"
 	lb "github.com/zqfan/tencentcloud-sdk-go/services/lb/unversioned"
 )
 
@@ -45,3 +46,35 @@ func waitForLBTaskFinish(client *lb.Client, taskid *int) error {
 		return nil
 	})
 }
+
+// check that the listener belongs to the load balancer and the location belongs to the listener,
+// the location is required by a layer 7 listener and can't be specified for a layer 4 listener
+func checkForwardLBListener(client *lb.Client, lbid, listenerId, locationId string) error {
+	req := lb.NewDescribeForwardLBListenersRequest()
+	req.LoadBalancerId = common.StringPtr(lbid)
+	req.ListenerIds = common.StringPtrs([]string{listenerId})
+	resp, err := client.DescribeForwardLBListeners(req)
+	if err != nil {
+		return err
+	}
+	if len(resp.ListenerSet) < 1 || resp.ListenerSet[0] == nil {
+		return fmt.Errorf("listener %s is not found in LB %s", listenerId, lbid)
+	}
+
+	listener := resp.ListenerSet[0]
+	if listener.Protocol == nil || (*listener.Protocol != lb.LBListenerProtocolHTTP && *listener.Protocol != lb.LBListenerProtocolHTTPS) {
+		if locationId != "" {
+			return fmt.Errorf("location_id %s can not be specified for the layer 4 listener %s", locationId, listenerId)
+		}
+		return nil
+	}
+	if locationId == "" {
+		return fmt.Errorf("location_id is required by the layer 7 listener %s", listenerId)
+	}
+	for _, rule := range listener.Rules {
+		if rule.LocationId != nil && *rule.LocationId == locationId {
+			return nil
+		}
+	}
+	return fmt.Errorf("location %s is not found in listener %s of LB %s", locationId, listenerId, lbid)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
 )
 
@@ -557,6 +559,28 @@ func flattenDataDiskMappings(list []*as.DataDisk) []map[string]interface{} {
 	return result
 }
 
+func flattenAsForwardLoadBalancers(list []*as.ForwardLoadBalancer) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, v := range list {
+		targetAttributes := make([]map[string]interface{}, 0, len(v.TargetAttributes))
+		for _, vv := range v.TargetAttributes {
+			targetAttribute := map[string]interface{}{
+				"port":   int(*vv.Port),
+				"weight": int(*vv.Weight),
+			}
+			targetAttributes = append(targetAttributes, targetAttribute)
+		}
+		forwardLoadBalancer := map[string]interface{}{
+			"load_balancer_id": pointerToString(v.LoadBalancerId),
+			"listener_id":      pointerToString(v.ListenerId),
+			"location_id":      pointerToString(v.LocationId),
+			"target_attribute": targetAttributes,
+		}
+		result = append(result, forwardLoadBalancer)
+	}
+	return result
+}
+
 func flattenInstanceTagsMapping(list []*as.InstanceTag) map[string]interface{} {
 	result := make(map[string]interface{}, len(list))
 	for _, v := range list {
@@ -564,3 +588,348 @@ func flattenInstanceTagsMapping(list []*as.InstanceTag) map[string]interface{} {
 	}
 	return result
 }
+
+func (me *AsService) EnableAutoScalingGroup(ctx context.Context, scalingGroupId string) error {
+	logId := GetLogId(ctx)
+	request := as.NewEnableAutoScalingGroupRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	response, err := me.client.UseAsClient().EnableAutoScalingGroup(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *AsService) DisableAutoScalingGroup(ctx context.Context, scalingGroupId string) error {
+	logId := GetLogId(ctx)
+	request := as.NewDisableAutoScalingGroupRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	response, err := me.client.UseAsClient().DisableAutoScalingGroup(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *AsService) ModifyDesiredCapacity(ctx context.Context, scalingGroupId string, desiredCapacity int) error {
+	logId := GetLogId(ctx)
+	request := as.NewModifyDesiredCapacityRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	request.DesiredCapacity = intToPointer(desiredCapacity)
+	response, err := me.client.UseAsClient().ModifyDesiredCapacity(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *AsService) SetInstancesProtection(ctx context.Context, scalingGroupId string, instanceIds []string, protected bool) error {
+	logId := GetLogId(ctx)
+	request := as.NewSetInstancesProtectionRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	request.InstanceIds = make([]*string, 0, len(instanceIds))
+	for i := range instanceIds {
+		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
+	}
+	request.ProtectedFromScaleIn = &protected
+	response, err := me.client.UseAsClient().SetInstancesProtection(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *AsService) DescribeAutoScalingInstances(ctx context.Context, scalingGroupId string) (instances []*as.Instance, errRet error) {
+	logId := GetLogId(ctx)
+	request := as.NewDescribeAutoScalingInstancesRequest()
+	request.Filters = []*as.Filter{
+		{
+			Name:   stringToPointer("auto-scaling-group-id"),
+			Values: []*string{&scalingGroupId},
+		},
+	}
+
+	var offset int64 = 0
+	var pageSize int64 = 100
+	instances = make([]*as.Instance, 0)
+	for {
+		request.Offset = &offset
+		request.Limit = &pageSize
+		response, err := me.client.UseAsClient().DescribeAutoScalingInstances(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || len(response.Response.AutoScalingInstanceSet) < 1 {
+			break
+		}
+		instances = append(instances, response.Response.AutoScalingInstanceSet...)
+		if int64(len(response.Response.AutoScalingInstanceSet)) < pageSize {
+			break
+		}
+		offset += pageSize
+	}
+	return
+}
+
+func (me *AsService) DescribeAutoScalingActivitiesByFilter(ctx context.Context, scalingGroupId, statusCode, activityType, startTime, endTime string) (activities []*as.Activity, errRet error) {
+	logId := GetLogId(ctx)
+	request := as.NewDescribeAutoScalingActivitiesRequest()
+	request.Filters = make([]*as.Filter, 0)
+	if scalingGroupId != "" {
+		filter := &as.Filter{
+			Name:   stringToPointer("auto-scaling-group-id"),
+			Values: []*string{&scalingGroupId},
+		}
+		request.Filters = append(request.Filters, filter)
+	}
+	if statusCode != "" {
+		filter := &as.Filter{
+			Name:   stringToPointer("activity-status-code"),
+			Values: []*string{&statusCode},
+		}
+		request.Filters = append(request.Filters, filter)
+	}
+	if activityType != "" {
+		filter := &as.Filter{
+			Name:   stringToPointer("activity-type"),
+			Values: []*string{&activityType},
+		}
+		request.Filters = append(request.Filters, filter)
+	}
+	if startTime != "" {
+		request.StartTime = &startTime
+	}
+	if endTime != "" {
+		request.EndTime = &endTime
+	}
+
+	offset := 0
+	pageSize := 100
+	activities = make([]*as.Activity, 0)
+	for {
+		request.Offset = intToPointer(offset)
+		request.Limit = intToPointer(pageSize)
+		response, err := me.client.UseAsClient().DescribeAutoScalingActivities(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || len(response.Response.ActivitySet) < 1 {
+			break
+		}
+		activities = append(activities, response.Response.ActivitySet...)
+		if len(response.Response.ActivitySet) < pageSize {
+			break
+		}
+		offset += pageSize
+	}
+	return
+}
+
+// wait until the scaling group has exactly desiredCapacity instances which are all in service and healthy,
+// the scaling activities failed after startTime stop the waiting with their causes
+func (me *AsService) WaitForScalingGroupCapacity(ctx context.Context, scalingGroupId string, desiredCapacity int, startTime string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		instances, err := me.DescribeAutoScalingInstances(ctx, scalingGroupId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		healthyCount := 0
+		for _, instance := range instances {
+			if pointerToString(instance.LifeCycleState) == SCALING_GROUP_INSTANCE_LIFE_CYCLE_STATE_IN_SERVICE &&
+				pointerToString(instance.HealthStatus) == SCALING_GROUP_INSTANCE_HEALTH_STATUS_HEALTHY {
+				healthyCount++
+			}
+		}
+		if len(instances) == desiredCapacity && healthyCount == desiredCapacity {
+			return nil
+		}
+
+		activities, err := me.DescribeAutoScalingActivitiesByFilter(ctx, scalingGroupId,
+			SCALING_GROUP_ACTIVITY_STATUS_FAILED, "", startTime, "")
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(activities) > 0 {
+			causes := make([]string, 0, len(activities))
+			for _, activity := range activities {
+				causes = append(causes, fmt.Sprintf("activity %s(%s) failed, cause: %s, message: %s",
+					pointerToString(activity.ActivityId), pointerToString(activity.ActivityType),
+					pointerToString(activity.Cause), pointerToString(activity.StatusMessage)))
+			}
+			return resource.NonRetryableError(fmt.Errorf("scaling group %s can not reach the desired capacity %d: %s",
+				scalingGroupId, desiredCapacity, strings.Join(causes, "; ")))
+		}
+
+		return resource.RetryableError(fmt.Errorf("scaling group %s has %d instances and %d of them are healthy, waiting for desired capacity %d",
+			scalingGroupId, len(instances), healthyCount, desiredCapacity))
+	})
+}
+
+// remove and terminate the instances, the timeout should cover the lifecycle hooks of the scaling group
+func (me *AsService) RemoveInstances(ctx context.Context, scalingGroupId string, instanceIds []string, timeout time.Duration) error {
+	logId := GetLogId(ctx)
+	request := as.NewRemoveInstancesRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	request.InstanceIds = make([]*string, 0, len(instanceIds))
+	for i := range instanceIds {
+		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
+	}
+	response, err := me.client.UseAsClient().RemoveInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	activityId := *response.Response.ActivityId
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		status, err := me.DescribeActivityById(ctx, activityId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
+			return resource.RetryableError(fmt.Errorf("remove status is running(%s)", status))
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
+			return nil
+		}
+		return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (me *AsService) DescribeLifecycleHookByFilter(ctx context.Context, scalingGroupId string) (lifecycleHooks []*as.LifecycleHook, errRet error) {
+	logId := GetLogId(ctx)
+	request := as.NewDescribeLifecycleHooksRequest()
+	request.Filters = []*as.Filter{
+		{
+			Name:   stringToPointer("auto-scaling-group-id"),
+			Values: []*string{&scalingGroupId},
+		},
+	}
+
+	offset := 0
+	pageSize := 100
+	lifecycleHooks = make([]*as.LifecycleHook, 0)
+	for {
+		request.Offset = intToPointer(offset)
+		request.Limit = intToPointer(pageSize)
+		response, err := me.client.UseAsClient().DescribeLifecycleHooks(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || len(response.Response.LifecycleHookSet) < 1 {
+			break
+		}
+		lifecycleHooks = append(lifecycleHooks, response.Response.LifecycleHookSet...)
+		if len(response.Response.LifecycleHookSet) < pageSize {
+			break
+		}
+		offset += pageSize
+	}
+	return
+}
+
+func (me *AsService) CompleteLifecycleAction(ctx context.Context, lifecycleHookId, actionResult, instanceId, actionToken string) error {
+	logId := GetLogId(ctx)
+	request := as.NewCompleteLifecycleActionRequest()
+	request.LifecycleHookId = &lifecycleHookId
+	request.LifecycleActionResult = &actionResult
+	if instanceId != "" {
+		request.InstanceId = &instanceId
+	}
+	if actionToken != "" {
+		request.LifecycleActionToken = &actionToken
+	}
+	response, err := me.client.UseAsClient().CompleteLifecycleAction(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+// execute the scaling policy and wait for the scaling activity, it is retried while another activity is in progress
+func (me *AsService) ExecuteScalingPolicy(ctx context.Context, scalingPolicyId string, honorCooldown bool) (activityId string, errRet error) {
+	logId := GetLogId(ctx)
+	request := as.NewExecuteScalingPolicyRequest()
+	request.AutoScalingPolicyId = &scalingPolicyId
+	request.HonorCooldown = &honorCooldown
+
+	errRet = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		response, err := me.client.UseAsClient().ExecuteScalingPolicy(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.Code == AsScalingGroupInProgress {
+				return resource.RetryableError(sdkErr)
+			}
+			return resource.NonRetryableError(err)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		activityId = *response.Response.ActivityId
+		return nil
+	})
+	if errRet != nil {
+		return
+	}
+
+	errRet = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		status, err := me.DescribeActivityById(ctx, activityId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
+			return resource.RetryableError(fmt.Errorf("execute status is running(%s)", status))
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
+			return nil
+		}
+		return resource.NonRetryableError(fmt.Errorf("execute status is failed(%s)", status))
+	})
+	return
+}
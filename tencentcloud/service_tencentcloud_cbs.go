@@ -328,6 +328,141 @@ func (me *CbsService) DeleteSnapshotPolicy(ctx context.Context, policyId string)
 	return nil
 }
 
+func (me *CbsService) BindAutoSnapshotPolicy(ctx context.Context, policyId, diskId string) error {
+	logId := GetLogId(ctx)
+	request := cbs.NewBindAutoSnapshotPolicyRequest()
+	request.AutoSnapshotPolicyId = &policyId
+	request.DiskIds = []*string{&diskId}
+	response, err := me.client.UseCbsClient().BindAutoSnapshotPolicy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *CbsService) UnbindAutoSnapshotPolicy(ctx context.Context, policyId, diskId string) error {
+	logId := GetLogId(ctx)
+	request := cbs.NewUnbindAutoSnapshotPolicyRequest()
+	request.AutoSnapshotPolicyId = &policyId
+	request.DiskIds = []*string{&diskId}
+	response, err := me.client.UseCbsClient().UnbindAutoSnapshotPolicy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *CbsService) DescribeDiskAssociatedAutoSnapshotPolicy(ctx context.Context, diskId string) (policies []*cbs.AutoSnapshotPolicy, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewDescribeDiskAssociatedAutoSnapshotPolicyRequest()
+	request.DiskId = &diskId
+	response, err := me.client.UseCbsClient().DescribeDiskAssociatedAutoSnapshotPolicy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	policies = response.Response.AutoSnapshotPolicySet
+	return
+}
+
+func (me *CbsService) DescribeAttachedSnapshotPolicy(ctx context.Context, diskId, policyId string) (policy *cbs.AutoSnapshotPolicy, errRet error) {
+	policies, err := me.DescribeDiskAssociatedAutoSnapshotPolicy(ctx, diskId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	for _, item := range policies {
+		if item.AutoSnapshotPolicyId != nil && *item.AutoSnapshotPolicyId == policyId {
+			policy = item
+			return
+		}
+	}
+	return
+}
+
+func (me *CbsService) InquiryPriceCreateDisks(ctx context.Context, diskType string, diskSize int,
+	chargeType string, period int, projectId int) (price *cbs.Price, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewInquiryPriceCreateDisksRequest()
+	request.DiskType = &diskType
+	request.DiskSize = intToPointer(diskSize)
+	request.DiskChargeType = &chargeType
+	request.DiskCount = intToPointer(1)
+	request.ProjectId = intToPointer(projectId)
+	if chargeType == CBS_CHARGE_TYPE_PREPAID {
+		request.DiskChargePrepaid = &cbs.DiskChargePrepaid{
+			Period: intToPointer(period),
+		}
+	}
+	response, err := me.client.UseCbsClient().InquiryPriceCreateDisks(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	price = response.Response.DiskPrice
+	return
+}
+
+func (me *CbsService) InquiryPriceResizeDisk(ctx context.Context, diskId string, diskSize int) (price *cbs.PrepayPrice, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewInquiryPriceResizeDiskRequest()
+	request.DiskId = &diskId
+	request.DiskSize = intToPointer(diskSize)
+	response, err := me.client.UseCbsClient().InquiryPriceResizeDisk(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	price = response.Response.DiskPrice
+	return
+}
+
+func (me *CbsService) InquiryPriceRenewDisks(ctx context.Context, diskId string, period int) (price *cbs.PrepayPrice, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewInquiryPriceRenewDisksRequest()
+	request.DiskIds = []*string{&diskId}
+	request.DiskChargePrepaids = []*cbs.DiskChargePrepaid{
+		{
+			Period: intToPointer(period),
+		},
+	}
+	response, err := me.client.UseCbsClient().InquiryPriceRenewDisks(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	price = response.Response.DiskPrice
+	return
+}
+
 func flattenCbsTagsMapping(tags []*cbs.Tag) (mapping map[string]string) {
 	mapping = make(map[string]string)
 	for _, tag := range tags {
@@ -335,3 +470,112 @@ func flattenCbsTagsMapping(tags []*cbs.Tag) (mapping map[string]string) {
 	}
 	return
 }
+
+func (me *CbsService) ModifyDisksRenewFlag(ctx context.Context, diskId, renewFlag string) error {
+	logId := GetLogId(ctx)
+	request := cbs.NewModifyDisksRenewFlagRequest()
+	request.DiskIds = []*string{&diskId}
+	request.RenewFlag = &renewFlag
+	response, err := me.client.UseCbsClient().ModifyDisksRenewFlag(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *CbsService) RenewDisk(ctx context.Context, diskId string, period int) error {
+	logId := GetLogId(ctx)
+	request := cbs.NewRenewDiskRequest()
+	request.DiskId = &diskId
+	request.DiskChargePrepaid = &cbs.DiskChargePrepaid{
+		Period: intToPointer(period),
+	}
+	response, err := me.client.UseCbsClient().RenewDisk(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+func (me *CbsService) DescribeDiskConfigQuota(ctx context.Context, zones []string, chargeType string,
+	diskTypes []string, diskUsage string) (configs []*cbs.DiskConfig, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewDescribeDiskConfigQuotaRequest()
+	request.InquiryType = stringToPointer(CBS_CONFIG_INQUIRY_TYPE_CBS)
+	for i := range zones {
+		request.Zones = append(request.Zones, &zones[i])
+	}
+	if chargeType != "" {
+		request.DiskChargeType = &chargeType
+	}
+	for i := range diskTypes {
+		request.DiskTypes = append(request.DiskTypes, &diskTypes[i])
+	}
+	if diskUsage != "" {
+		request.DiskUsage = &diskUsage
+	}
+	response, err := me.client.UseCbsClient().DescribeDiskConfigQuota(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	configs = response.Response.DiskConfigSet
+	return
+}
+
+func (me *CbsService) DescribeDiskOperationLogs(ctx context.Context, diskIds []string) (logs []*cbs.DiskOperationLog, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewDescribeDiskOperationLogsRequest()
+	filter := &cbs.Filter{Name: stringToPointer("disk-id")}
+	for i := range diskIds {
+		filter.Values = append(filter.Values, &diskIds[i])
+	}
+	request.Filters = []*cbs.Filter{filter}
+	response, err := me.client.UseCbsClient().DescribeDiskOperationLogs(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	logs = response.Response.DiskOperationLogSet
+	return
+}
+
+func (me *CbsService) DescribeSnapshotOperationLogs(ctx context.Context, snapshotIds []string) (logs []*cbs.SnapshotOperationLog, errRet error) {
+	logId := GetLogId(ctx)
+	request := cbs.NewDescribeSnapshotOperationLogsRequest()
+	filter := &cbs.Filter{Name: stringToPointer("snapshot-id")}
+	for i := range snapshotIds {
+		filter.Values = append(filter.Values, &snapshotIds[i])
+	}
+	request.Filters = []*cbs.Filter{filter}
+	response, err := me.client.UseCbsClient().DescribeSnapshotOperationLogs(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	logs = response.Response.SnapshotOperationLogSet
+	return
+}
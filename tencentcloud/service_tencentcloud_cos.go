@@ -2,13 +2,26 @@ package tencentcloud
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/restxml"
 	"github.com/aws/aws-sdk-go/service/s3"
 
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -18,13 +31,103 @@ type CosService struct {
 	client *connectivity.TencentCloudClient
 }
 
+// The referer and domain configurations are COS extensions of the S3 API, so their requests are built
+// with the XML protocol of the S3 client from the following types.
+type cosRefererConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Status                  *string   `type:"string"`
+	RefererType             *string   `type:"string"`
+	DomainList              []*string `locationName:"DomainList" locationNameList:"Domain" type:"list"`
+	EmptyReferConfiguration *string   `type:"string"`
+}
+
+type cosPutBucketRefererInput struct {
+	_ struct{} `type:"structure" payload:"RefererConfiguration"`
+
+	RefererConfiguration *cosRefererConfiguration `locationName:"RefererConfiguration" type:"structure"`
+}
+
+type cosDomainRule struct {
+	_ struct{} `type:"structure"`
+
+	Status            *string `type:"string"`
+	Name              *string `type:"string"`
+	Type              *string `type:"string"`
+	ForcedReplacement *string `type:"string"`
+}
+
+type cosDomainConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	DomainRules []*cosDomainRule `locationName:"DomainRule" type:"list" flattened:"true"`
+}
+
+type cosPutBucketDomainInput struct {
+	_ struct{} `type:"structure" payload:"DomainConfiguration"`
+
+	DomainConfiguration *cosDomainConfiguration `locationName:"DomainConfiguration" type:"structure"`
+}
+
+// sendBucketRequest sends a request to the sub resource of the bucket, output is nil if the response has no body
+func (me *CosService) sendBucketRequest(name, method, bucket, subResource string, input, output interface{}) error {
+	op := &request.Operation{
+		Name:       name,
+		HTTPMethod: method,
+		HTTPPath:   "/?" + subResource,
+	}
+	if input == nil {
+		input = &struct{}{}
+	}
+	discardBody := output == nil
+	if discardBody {
+		output = &struct{}{}
+	}
+
+	req := me.client.UseCosClient().NewRequest(op, input, output)
+	req.Handlers.Build.PushBack(func(r *request.Request) {
+		r.HTTPRequest.URL.Host = bucket + "." + r.HTTPRequest.URL.Host
+		if r.Body == nil {
+			return
+		}
+		hash := md5.New()
+		if _, err := io.Copy(hash, r.Body); err != nil {
+			r.Error = err
+			return
+		}
+		if _, err := r.Body.Seek(0, io.SeekStart); err != nil {
+			r.Error = err
+			return
+		}
+		r.HTTPRequest.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(hash.Sum(nil)))
+	})
+	if discardBody {
+		req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	}
+	return req.Send()
+}
+
+func isCosNotFoundError(err error) bool {
+	if e, ok := err.(awserr.RequestFailure); ok {
+		return e.StatusCode() == 404
+	}
+	return false
+}
+
 func (me *CosService) HeadObject(ctx context.Context, bucket, key string) (info *s3.HeadObjectOutput, errRet error) {
+	return me.HeadObjectVersion(ctx, bucket, key, "")
+}
+
+func (me *CosService) HeadObjectVersion(ctx context.Context, bucket, key, versionId string) (info *s3.HeadObjectOutput, errRet error) {
 	logId := GetLogId(ctx)
 
 	request := s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
+	if versionId != "" {
+		request.VersionId = aws.String(versionId)
+	}
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -43,6 +146,30 @@ func (me *CosService) HeadObject(ctx context.Context, bucket, key string) (info
 	return response, nil
 }
 
+// DescribeObject returns the metadata of the latest version of the object, has is false if the object doesn't exist
+func (me *CosService) DescribeObject(ctx context.Context, bucket, key string) (info *s3.HeadObjectOutput, has bool, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	response, err := me.client.UseCosClient().HeadObject(&request)
+	if err != nil {
+		if isCosNotFoundError(err) {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "head object", request.String(), err.Error())
+		errRet = fmt.Errorf("cos head object error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "head object", request.String(), response.String())
+
+	return response, true, nil
+}
+
 func (me *CosService) DeleteObject(ctx context.Context, bucket, key string) (errRet error) {
 	logId := GetLogId(ctx)
 
@@ -259,6 +386,29 @@ func (me *CosService) GetBucketLifecycle(ctx context.Context, bucket string) (li
 				}
 				rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
 			}
+			// non_current_transition
+			if len(value.NoncurrentVersionTransitions) > 0 {
+				transitions := make([]interface{}, 0, len(value.NoncurrentVersionTransitions))
+				for _, v := range value.NoncurrentVersionTransitions {
+					t := make(map[string]interface{})
+					if v.NoncurrentDays != nil {
+						t["non_current_days"] = int(*v.NoncurrentDays)
+					}
+					if v.StorageClass != nil {
+						t["storage_class"] = *v.StorageClass
+					}
+					transitions = append(transitions, t)
+				}
+				rule["non_current_transition"] = schema.NewSet(nonCurrentTransitionHash, transitions)
+			}
+			// non_current_expiration
+			if value.NoncurrentVersionExpiration != nil {
+				e := make(map[string]interface{})
+				if value.NoncurrentVersionExpiration.NoncurrentDays != nil {
+					e["non_current_days"] = int(*value.NoncurrentVersionExpiration.NoncurrentDays)
+				}
+				rule["non_current_expiration"] = schema.NewSet(nonCurrentExpirationHash, []interface{}{e})
+			}
 
 			lifecycleRules = append(lifecycleRules, rule)
 		}
@@ -328,6 +478,29 @@ func (me *CosService) GetDataSourceBucketLifecycle(ctx context.Context, bucket s
 				}
 				rule["expiration"] = []interface{}{e}
 			}
+			// non_current_transition
+			if len(value.NoncurrentVersionTransitions) > 0 {
+				transitions := make([]interface{}, 0, len(value.NoncurrentVersionTransitions))
+				for _, v := range value.NoncurrentVersionTransitions {
+					t := make(map[string]interface{})
+					if v.NoncurrentDays != nil {
+						t["non_current_days"] = int(*v.NoncurrentDays)
+					}
+					if v.StorageClass != nil {
+						t["storage_class"] = *v.StorageClass
+					}
+					transitions = append(transitions, t)
+				}
+				rule["non_current_transition"] = transitions
+			}
+			// non_current_expiration
+			if value.NoncurrentVersionExpiration != nil {
+				e := make(map[string]interface{})
+				if value.NoncurrentVersionExpiration.NoncurrentDays != nil {
+					e["non_current_days"] = int(*value.NoncurrentVersionExpiration.NoncurrentDays)
+				}
+				rule["non_current_expiration"] = []interface{}{e}
+			}
 
 			lifecycleRules = append(lifecycleRules, rule)
 		}
@@ -362,6 +535,26 @@ func (me *CosService) GetBucketWebsite(ctx context.Context, bucket string) (webs
 	if response.ErrorDocument != nil {
 		website["error_document"] = *response.ErrorDocument.Key
 	}
+	if response.RedirectAllRequestsTo != nil {
+		website["redirect_all_requests_to"] = aws.StringValue(response.RedirectAllRequestsTo.Protocol)
+	}
+	if len(response.RoutingRules) > 0 {
+		routingRules := make([]map[string]interface{}, 0, len(response.RoutingRules))
+		for _, value := range response.RoutingRules {
+			rule := make(map[string]interface{})
+			if value.Condition != nil {
+				rule["condition_error_code"] = aws.StringValue(value.Condition.HttpErrorCodeReturnedEquals)
+				rule["condition_prefix"] = aws.StringValue(value.Condition.KeyPrefixEquals)
+			}
+			if value.Redirect != nil {
+				rule["redirect_protocol"] = aws.StringValue(value.Redirect.Protocol)
+				rule["redirect_replace_key"] = aws.StringValue(value.Redirect.ReplaceKeyWith)
+				rule["redirect_replace_key_prefix"] = aws.StringValue(value.Redirect.ReplaceKeyPrefixWith)
+			}
+			routingRules = append(routingRules, rule)
+		}
+		website["routing_rules"] = routingRules
+	}
 	if len(website) > 0 {
 		websites = append(websites, website)
 	}
@@ -369,6 +562,362 @@ func (me *CosService) GetBucketWebsite(ctx context.Context, bucket string) (webs
 	return
 }
 
+func (me *CosService) GetBucketVersioning(ctx context.Context, bucket string) (enabled bool, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	}
+	response, err := me.client.UseCosClient().GetBucketVersioning(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get bucket versioning", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get bucket versioning error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get bucket versioning", request.String(), response.String())
+
+	enabled = response.Status != nil && *response.Status == s3.BucketVersioningStatusEnabled
+	return
+}
+
+func (me *CosService) GetBucketReplication(ctx context.Context, bucket string) (replications []map[string]interface{}, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	}
+	replications = make([]map[string]interface{}, 0, 1)
+	response, err := me.client.UseCosClient().GetBucketReplication(&request)
+	if err != nil {
+		awsError, ok := err.(awserr.Error)
+		if ok && (awsError.Code() == "ReplicationConfigurationnotFoundError" ||
+			awsError.Code() == "ReplicationConfigurationNotFoundError") {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get bucket replication", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get bucket replication error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get bucket replication", request.String(), response.String())
+
+	if response.ReplicationConfiguration == nil || len(response.ReplicationConfiguration.Rules) == 0 {
+		return
+	}
+	rules := make([]map[string]interface{}, 0, len(response.ReplicationConfiguration.Rules))
+	for _, value := range response.ReplicationConfiguration.Rules {
+		rule := map[string]interface{}{
+			"id":     aws.StringValue(value.ID),
+			"status": aws.StringValue(value.Status),
+			"prefix": aws.StringValue(value.Prefix),
+		}
+		if value.Destination != nil {
+			rule["destination_bucket"] = aws.StringValue(value.Destination.Bucket)
+			rule["destination_storage_class"] = aws.StringValue(value.Destination.StorageClass)
+		}
+		rules = append(rules, rule)
+	}
+	replications = append(replications, map[string]interface{}{
+		"role":  aws.StringValue(response.ReplicationConfiguration.Role),
+		"rules": rules,
+	})
+	return
+}
+
+func (me *CosService) GetBucketObjectLock(ctx context.Context, bucket string) (objectLocks []map[string]interface{}, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	objectLocks = make([]map[string]interface{}, 0, 1)
+	response, err := me.client.UseCosClient().GetObjectLockConfiguration(&request)
+	if err != nil {
+		awsError, ok := err.(awserr.Error)
+		if (ok && awsError.Code() == "ObjectLockConfigurationNotFoundError") || isCosNotFoundError(err) {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get object lock configuration", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get object lock configuration error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get object lock configuration", request.String(), response.String())
+
+	configuration := response.ObjectLockConfiguration
+	if configuration == nil || aws.StringValue(configuration.ObjectLockEnabled) != s3.ObjectLockEnabledEnabled ||
+		configuration.Rule == nil || configuration.Rule.DefaultRetention == nil {
+		return
+	}
+	objectLocks = append(objectLocks, map[string]interface{}{
+		"retention_mode": aws.StringValue(configuration.Rule.DefaultRetention.Mode),
+		"retention_days": int(aws.Int64Value(configuration.Rule.DefaultRetention.Days)),
+	})
+	return
+}
+
+func (me *CosService) GetBucketTags(ctx context.Context, bucket string) (tags map[string]interface{}, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetBucketTaggingInput{
+		Bucket: aws.String(bucket),
+	}
+	tags = make(map[string]interface{})
+	response, err := me.client.UseCosClient().GetBucketTagging(&request)
+	if err != nil {
+		awsError, ok := err.(awserr.Error)
+		if ok && (awsError.Code() == "NoSuchTagSet" || awsError.Code() == "NoSuchTagSetError") {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get bucket tagging", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get bucket tagging error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get bucket tagging", request.String(), response.String())
+
+	for _, tag := range response.TagSet {
+		if tag.Key != nil {
+			tags[*tag.Key] = aws.StringValue(tag.Value)
+		}
+	}
+	return
+}
+
+func (me *CosService) GetBucketLogging(ctx context.Context, bucket string) (loggings []map[string]interface{}, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucket),
+	}
+	response, err := me.client.UseCosClient().GetBucketLogging(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get bucket logging", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get bucket logging error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get bucket logging", request.String(), response.String())
+
+	loggings = make([]map[string]interface{}, 0, 1)
+	if response.LoggingEnabled != nil && response.LoggingEnabled.TargetBucket != nil {
+		loggings = append(loggings, map[string]interface{}{
+			"target_bucket": *response.LoggingEnabled.TargetBucket,
+			"target_prefix": aws.StringValue(response.LoggingEnabled.TargetPrefix),
+		})
+	}
+	return
+}
+
+func (me *CosService) GetBucketEncryption(ctx context.Context, bucket string) (encryptions []map[string]interface{}, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	}
+	encryptions = make([]map[string]interface{}, 0, 1)
+	response, err := me.client.UseCosClient().GetBucketEncryption(&request)
+	if err != nil {
+		awsError, ok := err.(awserr.Error)
+		if ok && (awsError.Code() == "NoSuchEncryptionConfiguration" ||
+			awsError.Code() == "ServerSideEncryptionConfigurationNotFoundError") {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get bucket encryption", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get bucket encryption error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get bucket encryption", request.String(), response.String())
+
+	if response.ServerSideEncryptionConfiguration == nil {
+		return
+	}
+	for _, rule := range response.ServerSideEncryptionConfiguration.Rules {
+		if rule.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+		encryptions = append(encryptions, map[string]interface{}{
+			"sse_algorithm":     aws.StringValue(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+			"kms_master_key_id": aws.StringValue(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+		})
+		break
+	}
+	return
+}
+
+func (me *CosService) GetBucketPolicy(ctx context.Context, bucket string) (policy string, has bool, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+	response, err := me.client.UseCosClient().GetBucketPolicy(&request)
+	if err != nil {
+		awsError, ok := err.(awserr.Error)
+		if ok && (awsError.Code() == "NoSuchBucketPolicy" || awsError.Code() == "NoSuchBucket") {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "get bucket policy", request.String(), err.Error())
+		errRet = fmt.Errorf("cos get bucket policy error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "get bucket policy", request.String(), response.String())
+
+	policy, has = aws.StringValue(response.Policy), true
+	return
+}
+
+func (me *CosService) PutBucketPolicy(ctx context.Context, bucket, policy string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	}
+	response, err := me.client.UseCosClient().PutBucketPolicy(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket policy", request.String(), err.Error())
+		return fmt.Errorf("cos put bucket policy error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put bucket policy", request.String(), response.String())
+
+	return nil
+}
+
+func (me *CosService) DeleteBucketPolicy(ctx context.Context, bucket string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.DeleteBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+	response, err := me.client.UseCosClient().DeleteBucketPolicy(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "delete bucket policy", request.String(), err.Error())
+		return fmt.Errorf("cos delete bucket policy error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "delete bucket policy", request.String(), response.String())
+
+	return nil
+}
+
+func (me *CosService) GetBucketReferer(ctx context.Context, bucket string) (referers []map[string]interface{}, errRet error) {
+	logId := GetLogId(ctx)
+
+	referers = make([]map[string]interface{}, 0, 1)
+	response := cosRefererConfiguration{}
+	err := me.sendBucketRequest("GetBucketReferer", "GET", bucket, "referer", nil, &response)
+	if err != nil {
+		if isCosNotFoundError(err) {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, bucket [%s], reason[%s]\n",
+			logId, "get bucket referer", bucket, err.Error())
+		errRet = fmt.Errorf("cos get bucket referer error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, bucket [%s], response body [%s]\n",
+		logId, "get bucket referer", bucket, awsutil.Prettify(response))
+
+	if aws.StringValue(response.Status) != tencentCloudCosRefererStatusEnabled {
+		return
+	}
+	referer := map[string]interface{}{
+		"allow_empty_referer": aws.StringValue(response.EmptyReferConfiguration) != tencentCloudCosEmptyRefererDeny,
+	}
+	if aws.StringValue(response.RefererType) == tencentCloudCosRefererTypeBlackList {
+		referer["deny_domains"] = flattenStringList(response.DomainList)
+	} else {
+		referer["allow_domains"] = flattenStringList(response.DomainList)
+	}
+	referers = append(referers, referer)
+	return
+}
+
+func (me *CosService) PutBucketReferer(ctx context.Context, bucket string, referer *cosRefererConfiguration) (errRet error) {
+	logId := GetLogId(ctx)
+
+	input := cosPutBucketRefererInput{
+		RefererConfiguration: referer,
+	}
+	err := me.sendBucketRequest("PutBucketReferer", "PUT", bucket, "referer", &input, nil)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket referer", awsutil.Prettify(input), err.Error())
+		return fmt.Errorf("cos put bucket referer error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s]\n",
+		logId, "put bucket referer", awsutil.Prettify(input))
+
+	return nil
+}
+
+func (me *CosService) GetBucketDomains(ctx context.Context, bucket string) (domains []*cosDomainRule, errRet error) {
+	logId := GetLogId(ctx)
+
+	response := cosDomainConfiguration{}
+	err := me.sendBucketRequest("GetBucketDomain", "GET", bucket, "domain", nil, &response)
+	if err != nil {
+		if isCosNotFoundError(err) {
+			return
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, bucket [%s], reason[%s]\n",
+			logId, "get bucket domain", bucket, err.Error())
+		errRet = fmt.Errorf("cos get bucket domain error: %s, bucket: %s", err.Error(), bucket)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, bucket [%s], response body [%s]\n",
+		logId, "get bucket domain", bucket, awsutil.Prettify(response))
+
+	domains = response.DomainRules
+	return
+}
+
+// PutBucketDomains replaces all the custom domains of the bucket, they are deleted if domains is empty
+func (me *CosService) PutBucketDomains(ctx context.Context, bucket string, domains []*cosDomainRule) (errRet error) {
+	logId := GetLogId(ctx)
+
+	if len(domains) == 0 {
+		err := me.sendBucketRequest("DeleteBucketDomain", "DELETE", bucket, "domain", nil, nil)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, bucket [%s], reason[%s]\n",
+				logId, "delete bucket domain", bucket, err.Error())
+			return fmt.Errorf("cos delete bucket domain error: %s, bucket: %s", err.Error(), bucket)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, bucket [%s]\n", logId, "delete bucket domain", bucket)
+		return nil
+	}
+
+	input := cosPutBucketDomainInput{
+		DomainConfiguration: &cosDomainConfiguration{
+			DomainRules: domains,
+		},
+	}
+	err := me.sendBucketRequest("PutBucketDomain", "PUT", bucket, "domain", &input, nil)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put bucket domain", awsutil.Prettify(input), err.Error())
+		return fmt.Errorf("cos put bucket domain error: %s, bucket: %s", err.Error(), bucket)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s]\n",
+		logId, "put bucket domain", awsutil.Prettify(input))
+
+	return nil
+}
+
 func (me *CosService) ListBuckets(ctx context.Context) (buckets []*s3.Bucket, errRet error) {
 	logId := GetLogId(ctx)
 
@@ -388,21 +937,423 @@ func (me *CosService) ListBuckets(ctx context.Context) (buckets []*s3.Bucket, er
 }
 
 func (me *CosService) ListObjects(ctx context.Context, bucket string) (objects []*s3.Object, errRet error) {
+	return me.ListObjectsByPrefix(ctx, bucket, "")
+}
+
+func (me *CosService) ListObjectsByPrefix(ctx context.Context, bucket, prefix string) (objects []*s3.Object, errRet error) {
 	logId := GetLogId(ctx)
 
 	request := s3.ListObjectsInput{
 		Bucket: aws.String(bucket),
 	}
-	response, err := me.client.UseCosClient().ListObjects(&request)
+	if prefix != "" {
+		request.Prefix = aws.String(prefix)
+	}
+	for {
+		response, err := me.client.UseCosClient().ListObjects(&request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "get object list", request.String(), err.Error())
+			errRet = fmt.Errorf("cos get object list error: %s", err.Error())
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, "get object list", request.String(), response.String())
+
+		objects = append(objects, response.Contents...)
+		if response.IsTruncated == nil || !*response.IsTruncated || len(response.Contents) == 0 {
+			break
+		}
+		if response.NextMarker != nil && *response.NextMarker != "" {
+			request.Marker = response.NextMarker
+		} else {
+			request.Marker = response.Contents[len(response.Contents)-1].Key
+		}
+	}
+	return
+}
+
+func (me *CosService) PutObject(ctx context.Context, request *s3.PutObjectInput) (errRet error) {
+	logId := GetLogId(ctx)
+
+	response, err := me.client.UseCosClient().PutObject(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "put object", request.String(), err.Error())
+		return fmt.Errorf("cos put object error: %s, bucket: %s, object: %s", err.Error(), *request.Bucket, *request.Key)
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "put object", request.String(), response.String())
+
+	return nil
+}
+
+func (me *CosService) CopyObject(ctx context.Context, request *s3.CopyObjectInput) (result *s3.CopyObjectResult, versionId string, errRet error) {
+	logId := GetLogId(ctx)
+
+	response, err := me.client.UseCosClient().CopyObject(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "copy object", request.String(), err.Error())
+		errRet = fmt.Errorf("cos copy object error: %s, bucket: %s, object: %s", err.Error(), *request.Bucket, *request.Key)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "copy object", request.String(), response.String())
+
+	result = response.CopyObjectResult
+	versionId = aws.StringValue(response.VersionId)
+	return
+}
+
+// PresignObjectUrl signs the url to get or put the object, the url is not logged as it grants the access
+func (me *CosService) PresignObjectUrl(ctx context.Context, bucket, key, method, versionId string, expire time.Duration) (signedUrl string, errRet error) {
+	logId := GetLogId(ctx)
+
+	client := me.client.UseCosClient()
+	var req *request.Request
+	switch method {
+	case "GET":
+		input := s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if versionId != "" {
+			input.VersionId = aws.String(versionId)
+		}
+		req, _ = client.GetObjectRequest(&input)
+	case "PUT":
+		req, _ = client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	default:
+		errRet = fmt.Errorf("cos presign object url error: method %s is not supported", method)
+		return
+	}
+
+	signedUrl, err := req.Presign(expire)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, bucket [%s], object [%s], method [%s], reason[%s]\n",
+			logId, "presign object url", bucket, key, method, err.Error())
+		errRet = fmt.Errorf("cos presign object url error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, bucket [%s], object [%s], method [%s], expire [%s]\n",
+		logId, "presign object url", bucket, key, method, expire)
+
+	return
+}
+
+// GetMultipartUploadParts returns the parts of the latest unfinished upload of the object with the storage class,
+// the unfinished uploads of the object with another storage class are aborted as they can't be resumed.
+func (me *CosService) GetMultipartUploadParts(ctx context.Context, bucket, key, storageClass string) (uploadId string, parts map[int64]*s3.Part, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	}
+	response, err := me.client.UseCosClient().ListMultipartUploads(&request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "list multipart uploads", request.String(), err.Error())
+		errRet = fmt.Errorf("cos list multipart uploads error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "list multipart uploads", request.String(), response.String())
+
+	if storageClass == "" {
+		storageClass = s3.StorageClassStandard
+	}
+
+	// resume the latest unfinished upload of the object
+	var upload *s3.MultipartUpload
+	for _, item := range response.Uploads {
+		if item.Key == nil || *item.Key != key || item.UploadId == nil {
+			continue
+		}
+		if item.StorageClass != nil && *item.StorageClass != storageClass {
+			if err := me.AbortMultipartUpload(ctx, bucket, key, *item.UploadId); err != nil {
+				errRet = err
+				return
+			}
+			continue
+		}
+		if upload == nil || (item.Initiated != nil && upload.Initiated != nil && item.Initiated.After(*upload.Initiated)) {
+			upload = item
+		}
+	}
+	if upload == nil {
+		return
+	}
+	uploadId = *upload.UploadId
+	parts = make(map[int64]*s3.Part)
+
+	partRequest := s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadId),
+	}
+	for {
+		partResponse, err := me.client.UseCosClient().ListParts(&partRequest)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "list parts", partRequest.String(), err.Error())
+			errRet = fmt.Errorf("cos list parts error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, "list parts", partRequest.String(), partResponse.String())
+
+		for _, part := range partResponse.Parts {
+			if part.PartNumber != nil {
+				parts[*part.PartNumber] = part
+			}
+		}
+		if partResponse.IsTruncated == nil || !*partResponse.IsTruncated || partResponse.NextPartNumberMarker == nil {
+			break
+		}
+		partRequest.PartNumberMarker = partResponse.NextPartNumberMarker
+	}
+	return
+}
+
+func (me *CosService) AbortMultipartUpload(ctx context.Context, bucket, key, uploadId string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadId),
+	}
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "abort multipart upload", request.String(), errRet.Error())
+		}
+	}()
+	response, err := me.client.UseCosClient().AbortMultipartUpload(&request)
 	if err != nil {
+		errRet = fmt.Errorf("cos abort multipart upload error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+		return
+	}
+
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, "abort multipart upload", request.String(), response.String())
+
+	return nil
+}
+
+func (me *CosService) UploadPart(ctx context.Context, bucket, key, uploadId string, partNumber int64, body io.ReadSeeker, contentMd5 string) (etag string, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := s3.UploadPartInput{
+		Bucket:     aws.String(bucket),
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadId),
+		PartNumber: aws.Int64(partNumber),
+		Body:       body,
+		ContentMD5: aws.String(contentMd5),
+	}
+	for retry := 0; ; retry++ {
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			errRet = err
+			return
+		}
+		response, err := me.client.UseCosClient().UploadPart(&request)
+		if err == nil {
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, "upload part", request.String(), response.String())
+			etag = strings.Trim(aws.StringValue(response.ETag), `"`)
+			return
+		}
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, "get object list", request.String(), err.Error())
-		errRet = fmt.Errorf("cos get object list error: %s", err.Error())
+			logId, "upload part", request.String(), err.Error())
+		if retry >= tencentCloudCosUploadPartRetries {
+			errRet = fmt.Errorf("cos upload part %d error: %s, bucket: %s, object: %s", partNumber, err.Error(), bucket, key)
+			return
+		}
+		time.Sleep(time.Duration(retry+1) * time.Second)
+	}
+}
+
+// PutObjectMultipart uploads the file in parts of partSize bytes with concurrency workers. The part size is
+// raised to a whole number of MB if the file needs more parts than COS allows with it. The parts of an
+// unfinished upload of the same object left by a former failure are reused if their size and MD5 still match.
+// COS does not return the acl and headers of an unfinished upload, so they are checked after a resumed upload
+// is completed, the acl is applied again and the file is uploaded again if the headers don't match.
+func (me *CosService) PutObjectMultipart(ctx context.Context, request *s3.CreateMultipartUploadInput, file *os.File, size, partSize int64, concurrency int) (etag string, errRet error) {
+	logId := GetLogId(ctx)
+	bucket, key := *request.Bucket, *request.Key
+
+	if (size+partSize-1)/partSize > tencentCloudCosMaxPartCount {
+		minPartSize := (size + tencentCloudCosMaxPartCount - 1) / tencentCloudCosMaxPartCount
+		newPartSize := (minPartSize + 1024*1024 - 1) / (1024 * 1024) * (1024 * 1024)
+		if newPartSize > tencentCloudCosMaxPartSize {
+			errRet = fmt.Errorf("cos object %s in bucket %s is too large to upload: %d bytes", key, bucket, size)
+			return
+		}
+		log.Printf("[WARN]%s object %s in bucket %s needs more than %d parts of %d bytes, use parts of %d bytes instead\n",
+			logId, key, bucket, tencentCloudCosMaxPartCount, partSize, newPartSize)
+		partSize = newPartSize
+	}
+
+	etag, resumed, err := me.putObjectMultipart(ctx, request, file, size, partSize, concurrency, true)
+	if err != nil || !resumed {
+		errRet = err
+		return
+	}
+
+	response, err := me.HeadObject(ctx, bucket, key)
+	if err != nil {
+		errRet = err
+		return
+	}
+	headers := [][2]*string{
+		{request.CacheControl, response.CacheControl},
+		{request.ContentDisposition, response.ContentDisposition},
+		{request.ContentEncoding, response.ContentEncoding},
+		{request.ContentType, response.ContentType},
+	}
+	for _, header := range headers {
+		if header[0] != nil && aws.StringValue(header[0]) != aws.StringValue(header[1]) {
+			log.Printf("[WARN]%s headers of the resumed upload of object %s in bucket %s don't match, upload it again\n",
+				logId, key, bucket)
+			etag, _, errRet = me.putObjectMultipart(ctx, request, file, size, partSize, concurrency, false)
+			return
+		}
+	}
+	if request.ACL != nil {
+		errRet = me.PutObjectAcl(ctx, bucket, key, *request.ACL)
+	}
+	return
+}
+
+func (me *CosService) putObjectMultipart(ctx context.Context, request *s3.CreateMultipartUploadInput, file *os.File, size, partSize int64,
+	concurrency int, resume bool) (etag string, resumed bool, errRet error) {
+	logId := GetLogId(ctx)
+	bucket, key := *request.Bucket, *request.Key
+
+	var (
+		uploadId string
+		uploaded map[int64]*s3.Part
+		err      error
+	)
+	if resume {
+		uploadId, uploaded, err = me.GetMultipartUploadParts(ctx, bucket, key, aws.StringValue(request.StorageClass))
+		if err != nil {
+			errRet = err
+			return
+		}
+		resumed = uploadId != ""
+	}
+	if uploadId == "" {
+		response, err := me.client.UseCosClient().CreateMultipartUpload(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, "create multipart upload", request.String(), err.Error())
+			errRet = fmt.Errorf("cos create multipart upload error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, "create multipart upload", request.String(), response.String())
+		uploadId = *response.UploadId
+	}
+
+	partCount := (size + partSize - 1) / partSize
+	completedParts := make([]*s3.CompletedPart, partCount)
+	partNumbers := make(chan int64, partCount)
+	for i := int64(1); i <= partCount; i++ {
+		partNumbers <- i
+	}
+	close(partNumbers)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partNumber := range partNumbers {
+				mu.Lock()
+				failed := firstErr != nil
+				mu.Unlock()
+				if failed {
+					return
+				}
+
+				offset := (partNumber - 1) * partSize
+				length := partSize
+				if offset+length > size {
+					length = size - offset
+				}
+				section := io.NewSectionReader(file, offset, length)
+				hash := md5.New()
+				_, err := io.Copy(hash, section)
+				if err == nil {
+					sum := hash.Sum(nil)
+					partEtag := hex.EncodeToString(sum)
+					if part, ok := uploaded[partNumber]; !ok || aws.Int64Value(part.Size) != length ||
+						strings.Trim(aws.StringValue(part.ETag), `"`) != partEtag {
+						partEtag, err = me.UploadPart(ctx, bucket, key, uploadId, partNumber, section,
+							base64.StdEncoding.EncodeToString(sum))
+					}
+					completedParts[partNumber-1] = &s3.CompletedPart{
+						ETag:       aws.String(partEtag),
+						PartNumber: aws.Int64(partNumber),
+					}
+				}
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		errRet = fmt.Errorf("%s, the uploaded parts of upload %s will be resumed next time", firstErr.Error(), uploadId)
+		return
+	}
+
+	completeRequest := s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadId),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	}
+	response, err := me.client.UseCosClient().CompleteMultipartUpload(&completeRequest)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, "complete multipart upload", completeRequest.String(), err.Error())
+		errRet = fmt.Errorf("cos complete multipart upload error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, "get object list", request.String(), response.String())
+		logId, "complete multipart upload", completeRequest.String(), response.String())
 
-	objects = response.Contents
+	etag = strings.Trim(aws.StringValue(response.ETag), `"`)
 	return
 }
+
+// cosCopyObjectSource returns the copy source of an object, the source can be in another region
+func cosCopyObjectSource(bucket, key, region, versionId string) string {
+	segments := strings.Split(key, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	source := fmt.Sprintf("%s.cos.%s.myqcloud.com/%s", bucket, region, strings.Join(segments, "/"))
+	if versionId != "" {
+		source += "?versionId=" + url.QueryEscape(versionId)
+	}
+	return source
+}
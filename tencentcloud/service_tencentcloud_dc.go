@@ -324,3 +324,257 @@ func (me *DcService) ModifyDirectConnectTunnelAttribute(ctx context.Context, dcx
 	}
 	return
 }
+
+func (me *DcService) DescribeDirectConnect(ctx context.Context, dcId string) (info dc.DirectConnect, has int64, errRet error) {
+
+	infos, err := me.DescribeDirectConnects(ctx, dcId, "")
+
+	if err != nil {
+		errRet = err
+		return
+	}
+	has = int64(len(infos))
+
+	if has > 0 {
+		info = infos[0]
+	}
+	return
+}
+
+func (me *DcService) CreateDirectConnect(ctx context.Context, name, accessPointId, lineOperator,
+	location, portType, circuitCode, redundantDcId,
+	tencentAddress, customerAddress,
+	customerName, customerEmail, customerPhone,
+	faultReportContactPerson, faultReportContactPhone string,
+	bandwidth, vlan int64) (dcId string, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := dc.NewCreateDirectConnectRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	request.DirectConnectName = &name
+	request.AccessPointId = &accessPointId
+	request.LineOperator = &lineOperator
+	request.Location = &location
+
+	if portType != "" {
+		request.PortType = &portType
+	}
+	if circuitCode != "" {
+		request.CircuitCode = &circuitCode
+	}
+	if redundantDcId != "" {
+		request.RedundantDirectConnectId = &redundantDcId
+	}
+	if tencentAddress != "" {
+		request.TencentAddress = &tencentAddress
+	}
+	if customerAddress != "" {
+		request.CustomerAddress = &customerAddress
+	}
+	if customerName != "" {
+		request.CustomerName = &customerName
+	}
+	if customerEmail != "" {
+		request.CustomerContactMail = &customerEmail
+	}
+	if customerPhone != "" {
+		request.CustomerContactNumber = &customerPhone
+	}
+	if faultReportContactPerson != "" {
+		request.FaultReportContactPerson = &faultReportContactPerson
+	}
+	if faultReportContactPhone != "" {
+		request.FaultReportContactNumber = &faultReportContactPhone
+	}
+	if bandwidth > 0 {
+		request.Bandwidth = &bandwidth
+	}
+	if vlan >= 0 {
+		request.Vlan = &vlan
+	}
+
+	response, err := me.client.UseDcClient().CreateDirectConnect(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	if len(response.Response.DirectConnectIdSet) != 1 {
+		errRet = fmt.Errorf("CreateDirectConnect  return %d DirectConnectIdSet",
+			len(response.Response.DirectConnectIdSet))
+		return
+	}
+	dcId = *response.Response.DirectConnectIdSet[0]
+	return
+}
+
+func (me *DcService) ModifyDirectConnectAttribute(ctx context.Context, dcId string,
+	name, circuitCode, tencentAddress, customerAddress,
+	customerName, customerEmail, customerPhone,
+	faultReportContactPerson, faultReportContactPhone string,
+	vlan int64) (errRet error) {
+
+	logId := GetLogId(ctx)
+	request := dc.NewModifyDirectConnectAttributeRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.DirectConnectId = &dcId
+	if name != "" {
+		request.DirectConnectName = &name
+	}
+	if circuitCode != "" {
+		request.CircuitCode = &circuitCode
+	}
+	if tencentAddress != "" {
+		request.TencentAddress = &tencentAddress
+	}
+	if customerAddress != "" {
+		request.CustomerAddress = &customerAddress
+	}
+	if customerName != "" {
+		request.CustomerName = &customerName
+	}
+	if customerEmail != "" {
+		request.CustomerContactMail = &customerEmail
+	}
+	if customerPhone != "" {
+		request.CustomerContactNumber = &customerPhone
+	}
+	if faultReportContactPerson != "" {
+		request.FaultReportContactPerson = &faultReportContactPerson
+	}
+	if faultReportContactPhone != "" {
+		request.FaultReportContactNumber = &faultReportContactPhone
+	}
+	if vlan >= 0 {
+		request.Vlan = &vlan
+	}
+
+	_, err := me.client.UseDcClient().ModifyDirectConnectAttribute(request)
+	if err != nil {
+		errRet = err
+	}
+	return
+}
+
+func (me *DcService) DeleteDirectConnect(ctx context.Context, dcId string) (errRet error) {
+
+	logId := GetLogId(ctx)
+	request := dc.NewDeleteDirectConnectRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.DirectConnectId = &dcId
+	_, err := me.client.UseDcClient().DeleteDirectConnect(request)
+	if err != nil {
+		errRet = err
+	}
+	return
+}
+
+func (me *DcService) DescribeAccessPoints(ctx context.Context, regionId string) (infos []dc.AccessPoint, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := dc.NewDescribeAccessPointsRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var offset int64 = 0
+	var limit int64 = 100
+	var total int64 = -1
+	var has = map[string]bool{}
+
+	if regionId != "" {
+		request.RegionId = &regionId
+	}
+	infos = make([]dc.AccessPoint, 0, 10)
+
+getMoreData:
+	if total >= 0 && offset >= total {
+		return
+	}
+	request.Limit = &limit
+	request.Offset = &offset
+
+	response, err := me.client.UseDcClient().DescribeAccessPoints(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if total < 0 {
+		total = *response.Response.TotalCount
+	}
+
+	if len(response.Response.AccessPointSet) > 0 {
+		offset += limit
+	} else {
+		//get empty set,we're done
+		return
+	}
+
+	for _, item := range response.Response.AccessPointSet {
+		if has[*item.AccessPointId] {
+			errRet = fmt.Errorf("get repeated access_point_id[%s] when doing DescribeAccessPoints", *item.AccessPointId)
+			return
+		}
+		has[*item.AccessPointId] = true
+		infos = append(infos, *item)
+	}
+	goto getMoreData
+}
+
+func (me *DcService) AcceptDirectConnectTunnel(ctx context.Context, dcxId string) (errRet error) {
+
+	logId := GetLogId(ctx)
+	request := dc.NewAcceptDirectConnectTunnelRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.DirectConnectTunnelId = &dcxId
+	_, err := me.client.UseDcClient().AcceptDirectConnectTunnel(request)
+	if err != nil {
+		errRet = err
+	}
+	return
+}
+
+func (me *DcService) RejectDirectConnectTunnel(ctx context.Context, dcxId string) (errRet error) {
+
+	logId := GetLogId(ctx)
+	request := dc.NewRejectDirectConnectTunnelRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.DirectConnectTunnelId = &dcxId
+	_, err := me.client.UseDcClient().RejectDirectConnectTunnel(request)
+	if err != nil {
+		errRet = err
+	}
+	return
+}
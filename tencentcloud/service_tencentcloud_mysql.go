@@ -1176,3 +1176,332 @@ func (me *MysqlService) IsolateDBInstance(ctx context.Context, mysqlId string) (
 
 	return
 }
+
+func (me *MysqlService) DescribeSlowLogs(ctx context.Context, mysqlId string) (slowLogs []*cdb.SlowLogInfo, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewDescribeSlowLogsRequest()
+	request.InstanceId = &mysqlId
+
+	var (
+		limit  int64 = 100
+		offset int64 = 0
+	)
+	request.Limit = &limit
+	request.Offset = &offset
+	slowLogs = make([]*cdb.SlowLogInfo, 0, limit)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for {
+		response, err := me.client.UseMysqlClient().DescribeSlowLogs(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		slowLogs = append(slowLogs, response.Response.Items...)
+		offset += limit
+		if len(response.Response.Items) < int(limit) || offset >= *response.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeBinlogs(ctx context.Context, mysqlId string) (binlogs []*cdb.BinlogInfo, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewDescribeBinlogsRequest()
+	request.InstanceId = &mysqlId
+
+	var (
+		limit  int64 = 100
+		offset int64 = 0
+	)
+	request.Limit = &limit
+	request.Offset = &offset
+	binlogs = make([]*cdb.BinlogInfo, 0, limit)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for {
+		response, err := me.client.UseMysqlClient().DescribeBinlogs(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		binlogs = append(binlogs, response.Response.Items...)
+		offset += limit
+		if len(response.Response.Items) < int(limit) || offset >= *response.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeDatabases(ctx context.Context, mysqlId, databaseRegexp string) (databases []string, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewDescribeDatabasesRequest()
+	request.InstanceId = &mysqlId
+	if databaseRegexp != "" {
+		request.DatabaseRegexp = &databaseRegexp
+	}
+
+	var (
+		limit  int64 = 100
+		offset int64 = 0
+	)
+	request.Limit = &limit
+	request.Offset = &offset
+	databases = make([]string, 0, limit)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for {
+		response, err := me.client.UseMysqlClient().DescribeDatabases(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		for _, item := range response.Response.Items {
+			databases = append(databases, *item)
+		}
+		offset += limit
+		if len(response.Response.Items) < int(limit) || offset >= *response.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeTables(ctx context.Context, mysqlId, database, tableRegexp string) (tables []string, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewDescribeTablesRequest()
+	request.InstanceId = &mysqlId
+	request.Database = &database
+	if tableRegexp != "" {
+		request.TableRegexp = &tableRegexp
+	}
+
+	var (
+		limit  int64 = 100
+		offset int64 = 0
+	)
+	request.Limit = &limit
+	request.Offset = &offset
+	tables = make([]string, 0, limit)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for {
+		response, err := me.client.UseMysqlClient().DescribeTables(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		for _, item := range response.Response.Items {
+			tables = append(tables, *item)
+		}
+		offset += limit
+		if len(response.Response.Items) < int(limit) || offset >= *response.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeDBSwitchRecords(ctx context.Context, mysqlId string) (records []*cdb.DBSwitchInfo, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewDescribeDBSwitchRecordsRequest()
+	request.InstanceId = &mysqlId
+
+	var (
+		limit  int64 = 100
+		offset int64 = 0
+	)
+	request.Limit = &limit
+	request.Offset = &offset
+	records = make([]*cdb.DBSwitchInfo, 0, limit)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for {
+		response, err := me.client.UseMysqlClient().DescribeDBSwitchRecords(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		records = append(records, response.Response.Items...)
+		offset += limit
+		if len(response.Response.Items) < int(limit) || offset >= *response.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeRoGroupOfInstance(ctx context.Context, masterInstanceId,
+	roInstanceId string) (roGroup *cdb.RoGroup, roInstance *cdb.RoInstanceInfo, errRet error) {
+
+	masterInfo, err := me.DescribeDBInstanceById(ctx, masterInstanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if masterInfo == nil {
+		return
+	}
+	for _, group := range masterInfo.RoGroups {
+		for _, instance := range group.RoInstances {
+			if instance.InstanceId != nil && *instance.InstanceId == roInstanceId {
+				roGroup = group
+				roInstance = instance
+				return
+			}
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeDrInfoOfInstance(ctx context.Context, masterRegion, masterInstanceId,
+	drInstanceId string) (drInfo *cdb.DrInfo, errRet error) {
+
+	//the master instance of a disaster recovery instance lives in another region
+	masterService := MysqlService{
+		client: connectivity.NewTencentCloudClient(me.client.SecretId, me.client.SecretKey, masterRegion),
+	}
+	masterInfo, err := masterService.DescribeDBInstanceById(ctx, masterInstanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if masterInfo == nil {
+		return
+	}
+	for _, info := range masterInfo.DrInfo {
+		if info.InstanceId != nil && *info.InstanceId == drInstanceId {
+			drInfo = info
+			return
+		}
+	}
+	return
+}
+
+func (me *MysqlService) DescribeDBPrice(ctx context.Context, zone string, memSize, volumeSize int64, payType string,
+	period int64, instanceRole string, protectMode int64) (price, originalPrice int64, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewDescribeDBPriceRequest()
+	goodsNum := int64(1)
+	request.Zone = &zone
+	request.GoodsNum = &goodsNum
+	request.Memory = &memSize
+	request.Volume = &volumeSize
+	request.PayType = &payType
+	request.InstanceRole = &instanceRole
+	request.ProtectMode = &protectMode
+	if payType == MYSQL_CHARGE_TYPE_PREPAID {
+		request.Period = &period
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	response, err := me.client.UseMysqlClient().DescribeDBPrice(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.Price == nil {
+		errRet = fmt.Errorf("the price of mysql instance is not returned by api[%s]", request.GetAction())
+		return
+	}
+	price = *response.Response.Price
+	if response.Response.OriginalPrice != nil {
+		originalPrice = *response.Response.OriginalPrice
+	}
+	return
+}
+
+func (me *MysqlService) InquiryPriceUpgradeInstances(ctx context.Context, mysqlId string, memSize, volumeSize,
+	protectMode uint64) (price int64, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := cdb.NewInquiryPriceUpgradeInstancesRequest()
+	request.InstanceId = &mysqlId
+	request.Memory = &memSize
+	request.Volume = &volumeSize
+	request.ProtectMode = &protectMode
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	response, err := me.client.UseMysqlClient().InquiryPriceUpgradeInstances(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.Price == nil {
+		errRet = fmt.Errorf("the price of mysql instance %s is not returned by api[%s]", mysqlId, request.GetAction())
+		return
+	}
+	price = *response.Response.Price
+	return
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -155,7 +156,8 @@ needMoreItems:
 func (me *RedisService) CreateInstances(ctx context.Context,
 	zoneName, typeId, password, vpcId, subnetId, redisName string,
 	memSize, projectId, port int64,
-	securityGroups []string) (dealId string, errRet error) {
+	securityGroups []string,
+	chargeType string, period, autoRenewFlag, shardNum, replicasNum int64) (dealId string, errRet error) {
 
 	logId := GetLogId(ctx)
 	request := redis.NewCreateInstancesRequest()
@@ -219,13 +221,26 @@ func (me *RedisService) CreateInstances(ctx context.Context,
 		umemSize           = uint64(memSize)
 		billingMode int64  = 0
 		goodsNum    uint64 = 1
-		period      uint64 = 1
+		uperiod     uint64 = 1
 	)
+	if chargeType == REDIS_CHARGE_TYPE_PREPAID {
+		billingMode = 1
+		uperiod = uint64(period)
+		uautoRenewFlag := uint64(autoRenewFlag)
+		request.AutoRenew = &uautoRenewFlag
+	}
 	request.VPort = &vport
 	request.MemSize = &umemSize
 	request.BillingMode = &billingMode
 	request.GoodsNum = &goodsNum
-	request.Period = &period
+	request.Period = &uperiod
+
+	if shardNum > 0 {
+		request.RedisShardNum = &shardNum
+	}
+	if replicasNum > 0 {
+		request.RedisReplicasNum = &replicasNum
+	}
 
 	if redisName != "" {
 		request.InstanceName = &redisName
@@ -441,6 +456,30 @@ func (me *RedisService) ModifyInstanceProjectId(ctx context.Context, redisId str
 
 }
 
+func (me *RedisService) ModifyAutoRenewFlag(ctx context.Context, redisId string, autoRenewFlag int64) (errRet error) {
+	logId := GetLogId(ctx)
+	request := redis.NewModifyInstanceRequest()
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	op := "modifyAutoRenew"
+	request.AutoRenew = &autoRenewFlag
+	request.Operation = &op
+	request.InstanceId = &redisId
+
+	respone, err := me.client.UseRedisClient().ModifyInstance(request)
+	if err == nil {
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+	}
+	errRet = err
+	return
+}
+
 func (me *RedisService) DescribeInstanceSecurityGroup(ctx context.Context, redisId string) (sg []string, errRet error) {
 
 	logId := GetLogId(ctx)
@@ -501,7 +540,61 @@ func (me *RedisService) DestroyPostpaidInstance(ctx context.Context, redisId str
 	return
 }
 
-func (me *RedisService) UpgradeInstance(ctx context.Context, redisId string, newMemSize int64) (dealId string, errRet error) {
+func (me *RedisService) DestroyPrepaidInstance(ctx context.Context, redisId string) (dealId string, errRet error) {
+	logId := GetLogId(ctx)
+	request := redis.NewDestroyPrepaidInstanceRequest()
+	request.InstanceId = &redisId
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	respone, err := me.client.UseRedisClient().DestroyPrepaidInstance(request)
+	if err == nil {
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+	} else {
+		errRet = err
+		return
+	}
+
+	dealId = *respone.Response.DealId
+	return
+}
+
+func (me *RedisService) RenewInstance(ctx context.Context, redisId string, period int64) (dealId string, errRet error) {
+	logId := GetLogId(ctx)
+
+	var uintPeriod = uint64(period)
+
+	request := redis.NewRenewInstanceRequest()
+	request.InstanceId = &redisId
+	request.Period = &uintPeriod
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	respone, err := me.client.UseRedisClient().RenewInstance(request)
+	if err == nil {
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+	} else {
+		errRet = err
+		return
+	}
+
+	dealId = *respone.Response.DealId
+	return
+}
+
+func (me *RedisService) UpgradeInstance(ctx context.Context, redisId string, newMemSize,
+	newShardNum, newReplicasNum int64) (dealId string, errRet error) {
 	logId := GetLogId(ctx)
 
 	var uintNewMemSize = uint64(newMemSize)
@@ -509,6 +602,14 @@ func (me *RedisService) UpgradeInstance(ctx context.Context, redisId string, new
 	request := redis.NewUpgradeInstanceRequest()
 	request.InstanceId = &redisId
 	request.MemSize = &uintNewMemSize
+	if newShardNum > 0 {
+		uintNewShardNum := uint64(newShardNum)
+		request.RedisShardNum = &uintNewShardNum
+	}
+	if newReplicasNum > 0 {
+		uintNewReplicasNum := uint64(newReplicasNum)
+		request.RedisReplicasNum = &uintNewReplicasNum
+	}
 
 	defer func() {
 		if errRet != nil {
@@ -561,6 +662,24 @@ func (me *RedisService) DescribeTaskInfo(ctx context.Context, redisId string, ta
 	return
 }
 
+func (me *RedisService) WaitForTaskFinish(ctx context.Context, redisId string, taskId int64, timeout time.Duration) (errRet error) {
+	errRet = resource.Retry(timeout, func() *resource.RetryError {
+		ok, err := me.DescribeTaskInfo(ctx, redisId, taskId)
+		if err != nil {
+			if _, ok := err.(*errors.TencentCloudSDKError); !ok {
+				return resource.RetryableError(err)
+			} else {
+				return resource.NonRetryableError(err)
+			}
+		}
+		if ok {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("redis task %d is processing", taskId))
+	})
+	return
+}
+
 func (me *RedisService) ResetPassword(ctx context.Context, redisId string, newPassword string) (taskId int64, errRet error) {
 
 	logId := GetLogId(ctx)
@@ -647,3 +766,374 @@ func (me *RedisService) DescribeAutoBackupConfig(ctx context.Context, redisId st
 	}
 	return
 }
+
+func (me *RedisService) DescribeInstanceParams(ctx context.Context, redisId string) (currentParams,
+	defaultParams map[string]string, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewDescribeInstanceParamsRequest()
+	request.InstanceId = &redisId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().DescribeInstanceParams(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	currentParams = make(map[string]string)
+	defaultParams = make(map[string]string)
+
+	var collect = func(name, currentValue, defaultValue *string) {
+		if name == nil {
+			return
+		}
+		if currentValue != nil {
+			currentParams[*name] = *currentValue
+		}
+		if defaultValue != nil {
+			defaultParams[*name] = *defaultValue
+		}
+	}
+	for _, param := range respone.Response.InstanceEnumParam {
+		collect(param.ParamName, param.CurrentValue, param.DefaultValue)
+	}
+	for _, param := range respone.Response.InstanceIntegerParam {
+		collect(param.ParamName, param.CurrentValue, param.DefaultValue)
+	}
+	for _, param := range respone.Response.InstanceTextParam {
+		collect(param.ParamName, param.CurrentValue, param.DefaultValue)
+	}
+	return
+}
+
+func (me *RedisService) ModifyInstanceParams(ctx context.Context, redisId string, params map[string]string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewModifyInstanceParamsRequest()
+	request.InstanceId = &redisId
+	request.InstanceParams = make([]*redis.InstanceParam, 0, len(params))
+	for k, v := range params {
+		key, value := k, v
+		request.InstanceParams = append(request.InstanceParams, &redis.InstanceParam{
+			Key:   &key,
+			Value: &value,
+		})
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().ModifyInstanceParams(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	if respone.Response.Changed != nil && !*respone.Response.Changed {
+		errRet = fmt.Errorf("redis %s params are not changed", redisId)
+	}
+	return
+}
+
+func (me *RedisService) DescribeInstanceParamRecords(ctx context.Context, redisId string) (records []*redis.InstanceParamHistory, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewDescribeInstanceParamRecordsRequest()
+	request.InstanceId = &redisId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var limit, offset uint64 = 100, 0
+	request.Limit = &limit
+	request.Offset = &offset
+	for {
+		respone, err := me.client.UseRedisClient().DescribeInstanceParamRecords(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+		records = append(records, respone.Response.InstanceParamHistory...)
+		offset += limit
+		if len(respone.Response.InstanceParamHistory) < int(limit) || int64(offset) >= *respone.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *RedisService) ManualBackupInstance(ctx context.Context, redisId, remark string) (taskId int64, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewManualBackupInstanceRequest()
+	request.InstanceId = &redisId
+	if remark != "" {
+		request.Remark = &remark
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().ManualBackupInstance(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	taskId = *respone.Response.TaskId
+	return
+}
+
+func (me *RedisService) DescribeInstanceBackups(ctx context.Context, redisId, beginTime,
+	endTime string) (backups []*redis.RedisBackupSet, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewDescribeInstanceBackupsRequest()
+	request.InstanceId = &redisId
+	if beginTime != "" {
+		request.BeginTime = &beginTime
+	}
+	if endTime != "" {
+		request.EndTime = &endTime
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var limit, offset int64 = 100, 0
+	request.Limit = &limit
+	request.Offset = &offset
+	for {
+		respone, err := me.client.UseRedisClient().DescribeInstanceBackups(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+		backups = append(backups, respone.Response.BackupSet...)
+		offset += limit
+		if len(respone.Response.BackupSet) < int(limit) || offset >= *respone.Response.TotalCount {
+			break
+		}
+	}
+	return
+}
+
+func (me *RedisService) DescribeBackupUrl(ctx context.Context, redisId, backupId string) (downloadUrls,
+	innerDownloadUrls []string, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewDescribeBackupUrlRequest()
+	request.InstanceId = &redisId
+	request.BackupId = &backupId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().DescribeBackupUrl(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	downloadUrls = make([]string, 0, len(respone.Response.DownloadUrl))
+	for _, v := range respone.Response.DownloadUrl {
+		downloadUrls = append(downloadUrls, *v)
+	}
+	innerDownloadUrls = make([]string, 0, len(respone.Response.InnerDownloadUrl))
+	for _, v := range respone.Response.InnerDownloadUrl {
+		innerDownloadUrls = append(innerDownloadUrls, *v)
+	}
+	return
+}
+
+func (me *RedisService) RestoreInstance(ctx context.Context, redisId, password, backupId string) (taskId int64, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewRestoreInstanceRequest()
+	request.InstanceId = &redisId
+	request.Password = &password
+	request.BackupId = &backupId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().RestoreInstance(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	taskId = *respone.Response.TaskId
+	return
+}
+
+func (me *RedisService) DescribeInstanceBackupById(ctx context.Context, redisId, backupId string) (backup *redis.RedisBackupSet, errRet error) {
+	backups, err := me.DescribeInstanceBackups(ctx, redisId, "", "")
+	if err != nil {
+		errRet = err
+		return
+	}
+	for _, item := range backups {
+		if item.BackupId != nil && *item.BackupId == backupId {
+			backup = item
+			return
+		}
+	}
+	return
+}
+
+func (me *RedisService) DescribeInstanceShards(ctx context.Context, redisId string, filterSlave bool) (shards []*redis.InstanceClusterShard, errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewDescribeInstanceShardsRequest()
+	request.InstanceId = &redisId
+	request.FilterSlave = &filterSlave
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().DescribeInstanceShards(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	shards = respone.Response.InstanceShards
+	return
+}
+
+func (me *RedisService) EnableReplicaReadonly(ctx context.Context, redisId string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewEnableReplicaReadonlyRequest()
+	request.InstanceId = &redisId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().EnableReplicaReadonly(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	if respone.Response.Status != nil && *respone.Response.Status != REDIS_OPERATION_STATUS_OK {
+		errRet = fmt.Errorf("redis enable replica readonly return status %s", *respone.Response.Status)
+	}
+	return
+}
+
+func (me *RedisService) DisableReplicaReadonly(ctx context.Context, redisId string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewDisableReplicaReadonlyRequest()
+	request.InstanceId = &redisId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().DisableReplicaReadonly(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	if respone.Response.Status != nil && *respone.Response.Status != REDIS_OPERATION_STATUS_OK {
+		errRet = fmt.Errorf("redis disable replica readonly return status %s", *respone.Response.Status)
+	}
+	return
+}
+
+func (me *RedisService) ModifyNetworkConfig(ctx context.Context, redisId, operation, vip,
+	vpcId, subnetId string) (errRet error) {
+	logId := GetLogId(ctx)
+
+	request := redis.NewModifyNetworkConfigRequest()
+	request.InstanceId = &redisId
+	request.Operation = &operation
+	if vip != "" {
+		request.Vip = &vip
+	}
+	if vpcId != "" {
+		request.VpcId = &vpcId
+	}
+	if subnetId != "" {
+		request.SubnetId = &subnetId
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+	respone, err := me.client.UseRedisClient().ModifyNetworkConfig(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), respone.ToJsonString())
+
+	if respone.Response.Status != nil && !*respone.Response.Status {
+		errRet = fmt.Errorf("redis modify network config %s fail", operation)
+	}
+	return
+}
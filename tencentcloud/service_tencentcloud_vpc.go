@@ -815,3 +815,34 @@ func (me *VpcService) CreateRoutes(ctx context.Context,
 
 	return
 }
+
+func (me *VpcService) InquiryPriceCreateVpnGateway(ctx context.Context, bandwidth int,
+	chargeType string, period int) (price *vpc.Price, errRet error) {
+
+	logId := GetLogId(ctx)
+	request := vpc.NewInquiryPriceCreateVpnGatewayRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	request.InternetMaxBandwidthOut = intToPointer(bandwidth)
+	request.InstanceChargeType = &chargeType
+	if chargeType == VPN_CHARGE_TYPE_PREPAID {
+		request.InstanceChargePrepaid = &vpc.InstanceChargePrepaid{
+			Period: intToPointer(period),
+		}
+	}
+	response, err := me.client.UseVpcClient().InquiryPriceCreateVpnGateway(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	price = response.Response.Price
+	return
+}
@@ -330,3 +330,21 @@ func validateAsScheduleTimestamp(v interface{}, k string) (ws []string, errors [
 	}
 	return
 }
+
+func validateCbsOperationLogTime(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := time.Parse(CBS_OPERATION_LOG_TIME_LAYOUT, value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as the format %s", value, CBS_OPERATION_LOG_TIME_LAYOUT))
+	}
+	return
+}
+
+func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := normalizeJsonString(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	}
+	return
+}